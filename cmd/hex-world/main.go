@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"image"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/sean/hex-map/pkg/export"
 	"github.com/sean/hex-map/pkg/hex"
 	"github.com/sean/hex-map/pkg/terrain"
 )
@@ -32,6 +36,20 @@ func main() {
 		handleValidateTerrain(os.Args[2:])
 	case "demo-terrain":
 		handleDemoTerrain(os.Args[2:])
+	case "find-path":
+		handleFindPath(os.Args[2:])
+	case "diff-terrain":
+		handleDiffTerrain(os.Args[2:])
+	case "histogram":
+		handleHistogram(os.Args[2:])
+	case "batch-generate":
+		handleBatchGenerate(os.Args[2:])
+	case "find-seed":
+		handleFindSeed(os.Args[2:])
+	case "animate-sealevel":
+		handleAnimateSeaLevel(os.Args[2:])
+	case "export-heightmap":
+		handleExportHeightmap(os.Args[2:])
 	default:
 		fmt.Printf("Unknown command: %s\n", command)
 		printUsage()
@@ -42,31 +60,50 @@ func printUsage() {
 	fmt.Println("hex-world - Hex Map World Generation Tool")
 	fmt.Println("")
 	fmt.Println("Hex Grid Commands:")
-	fmt.Println("  demo-coords     --size=WxH --topology=TYPE              Show coordinate system demo")
-	fmt.Println("  demo-distance   --from=Q,R --to=Q,R --topology=TYPE     Show distance calculation")
+	fmt.Println("  demo-coords     --size=WxH --topology=TYPE [--orientation=TYPE]  Show coordinate system demo")
+	fmt.Println("  demo-distance   --from=Q,R --to=Q,R --topology=TYPE [--orientation=TYPE]  Show distance calculation")
 	fmt.Println("")
 	fmt.Println("Terrain Generation Commands:")
 	fmt.Println("  generate-terrain --size=WxH --seed=N --output=FILE      Generate terrain and save to JSON")
-	fmt.Println("  terrain-stats   FILE.json                               Show terrain statistics")
+	fmt.Println("  terrain-stats   [--json] FILE.json                      Show terrain statistics")
 	fmt.Println("  validate-terrain FILE.json [--strict]                   Validate terrain realism")
 	fmt.Println("  demo-terrain    --size=WxH [--seed=N]                    Quick terrain demo with stats")
+	fmt.Println("  find-path       --input=FILE --from=Q,R --to=Q,R [--avoid-water]  Find a path across terrain")
+	fmt.Println("  diff-terrain    A.json B.json                           Compare two terrain files")
+	fmt.Println("  histogram       [--bins=N] FILE.json                    Show elevation distribution chart")
+	fmt.Println("  batch-generate  --seeds=N,N,... --output-dir=DIR         Generate one terrain file per seed")
+	fmt.Println("  find-seed       --seeds=N,N,... [--size=WxH]             Find the most realistic seed")
+	fmt.Println("  animate-sealevel --input=FILE --from=N --to=N --steps=N  Animate rising sea level as a GIF")
+	fmt.Println("  export-heightmap --input=FILE --output=FILE.png         Export elevations as a 16-bit grayscale PNG")
 	fmt.Println("")
 	fmt.Println("Options:")
 	fmt.Println("  --topology=TYPE     region (bounded) or world (toroidal)")
+	fmt.Println("  --orientation=TYPE  flat or pointy hex tops (demo-coords, demo-distance)")
 	fmt.Println("  --size=WxH          Grid dimensions (e.g., 100x100)")
 	fmt.Println("  --seed=N            Random seed for reproducible generation")
 	fmt.Println("  --output=FILE       Output filename for JSON data")
 	fmt.Println("  --land-ratio=N      Target land percentage (0.0-1.0, default: 0.29)")
-	fmt.Println("  --sea-level=N       Sea level in meters (default: 0)")
+	fmt.Println("  --sea-level=N       Sea level in meters (default: calibrated to the land ratio)")
+	fmt.Println("  --stream            Write terrain as JSON lines without buffering (generate-terrain)")
+	fmt.Println("  --falloff=SHAPE     Edge mask: none, radial (island), square (continent), ridged (archipelago)")
+	fmt.Println("  --falloff-strength=N  Steepness of the edge mask (default: 1.0)")
+	fmt.Println("  --heightmap=FILE    Build terrain from a grayscale PNG instead of noise (generate-terrain)")
 }
 
 func handleDemoCoords(args []string) {
 	fs := flag.NewFlagSet("demo-coords", flag.ExitOnError)
 	size := fs.String("size", "10x8", "Grid size as WIDTHxHEIGHT")
 	topology := fs.String("topology", "region", "Topology type: region or world")
+	orientation := fs.String("orientation", "flat", "Hex orientation: flat or pointy")
 	
 	fs.Parse(args)
 	
+	orient, err := parseOrientation(*orientation)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
 	// Parse size
 	parts := strings.Split(*size, "x")
 	if len(parts) != 2 {
@@ -94,10 +131,10 @@ func handleDemoCoords(args []string) {
 	}
 	
 	// Create grid and demonstrate
-	config := hex.GridConfig{Width: width, Height: height, Topology: topo}
+	config := hex.GridConfig{Width: width, Height: height, Topology: topo, Orientation: orient}
 	grid := hex.NewGrid(config)
 	
-	fmt.Printf("Hex Grid Demo - %dx%d %s topology\n", width, height, *topology)
+	fmt.Printf("Hex Grid Demo - %dx%d %s topology, %s-top hexes\n", width, height, *topology, *orientation)
 	fmt.Println(strings.Repeat("=", 50))
 	
 	// Show sample coordinates
@@ -112,16 +149,17 @@ func handleDemoCoords(args []string) {
 	}
 	
 	fmt.Println("\nSample coordinates:")
-	fmt.Println("Axial      | Offset  | Neighbors | Edge")
-	fmt.Println("-----------|---------|-----------|-----")
+	fmt.Println("Axial      | Offset  | Pixel (size 1)  | Neighbors | Edge")
+	fmt.Println("-----------|---------|-----------------|-----------|-----")
 	
 	for _, coord := range sampleCoords {
 		col, row := coord.ToOffset()
+		x, y := grid.ToPixel(coord, 1.0)
 		neighbors := coord.Neighbors(grid)
 		isEdge := coord.IsEdgeHex(grid)
 		
-		fmt.Printf("(%2d,%2d)    | (%d,%d)   | %d         | %v\n",
-			coord.Q, coord.R, col, row, len(neighbors), isEdge)
+		fmt.Printf("(%2d,%2d)    | (%d,%d)   | (%6.2f,%6.2f) | %d         | %v\n",
+			coord.Q, coord.R, col, row, x, y, len(neighbors), isEdge)
 	}
 	
 	// For world topology, show wrapping example
@@ -148,9 +186,16 @@ func handleDemoDistance(args []string) {
 	fromStr := fs.String("from", "0,0", "Starting coordinate as Q,R")
 	toStr := fs.String("to", "3,2", "Target coordinate as Q,R")
 	topology := fs.String("topology", "region", "Topology type: region or world")
+	orientation := fs.String("orientation", "flat", "Hex orientation: flat or pointy")
 	
 	fs.Parse(args)
 	
+	orient, err := parseOrientation(*orientation)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
 	// Parse coordinates
 	from, err := parseCoord(*fromStr)
 	if err != nil {
@@ -177,13 +222,16 @@ func handleDemoDistance(args []string) {
 	}
 	
 	// Create a reasonable grid size
-	config := hex.GridConfig{Width: 10, Height: 8, Topology: topo}
+	config := hex.GridConfig{Width: 10, Height: 8, Topology: topo, Orientation: orient}
 	grid := hex.NewGrid(config)
 	
-	fmt.Printf("Distance Demo - %s topology\n", *topology)
+	fromX, fromY := grid.ToPixel(from, 1.0)
+	toX, toY := grid.ToPixel(to, 1.0)
+	
+	fmt.Printf("Distance Demo - %s topology, %s-top hexes\n", *topology, *orientation)
 	fmt.Println(strings.Repeat("=", 30))
-	fmt.Printf("From: (%d,%d)\n", from.Q, from.R)
-	fmt.Printf("To:   (%d,%d)\n", to.Q, to.R)
+	fmt.Printf("From: (%d,%d) at pixel (%.2f,%.2f)\n", from.Q, from.R, fromX, fromY)
+	fmt.Printf("To:   (%d,%d) at pixel (%.2f,%.2f)\n", to.Q, to.R, toX, toY)
 	
 	// Calculate distance
 	distance := from.DistanceTo(to, grid)
@@ -212,6 +260,73 @@ func handleDemoDistance(args []string) {
 	}
 }
 
+func handleFindPath(args []string) {
+	fs := flag.NewFlagSet("find-path", flag.ExitOnError)
+	input := fs.String("input", "terrain.json", "Terrain JSON file to search")
+	fromStr := fs.String("from", "0,0", "Starting coordinate as Q,R")
+	toStr := fs.String("to", "1,0", "Target coordinate as Q,R")
+	avoidWater := fs.Bool("avoid-water", false, "Treat water tiles as impassable")
+	
+	fs.Parse(args)
+	
+	from, err := parseCoord(*fromStr)
+	if err != nil {
+		fmt.Printf("Error parsing from coordinate: %v\n", err)
+		return
+	}
+	
+	to, err := parseCoord(*toStr)
+	if err != nil {
+		fmt.Printf("Error parsing to coordinate: %v\n", err)
+		return
+	}
+	
+	grid, terrainData, err := terrain.LoadTerrainFile(*input)
+	if err != nil {
+		fmt.Printf("Error loading terrain: %v\n", err)
+		return
+	}
+	
+	if !grid.IsValid(from) || !grid.IsValid(to) {
+		fmt.Println("Error: from and to must both be on the terrain grid")
+		return
+	}
+	
+	tiles := make(map[hex.AxialCoord]*terrain.HexTile, len(terrainData.Tiles))
+	for _, tile := range terrainData.Tiles {
+		tiles[tile.Coordinates] = tile
+	}
+	
+	passable := func(coord hex.AxialCoord) bool {
+		tile, ok := tiles[coord]
+		return ok && (!*avoidWater || tile.IsLand)
+	}
+	
+	if !passable(grid.WrapCoord(from)) || !passable(grid.WrapCoord(to)) {
+		fmt.Println("No path exists: from or to is an impassable (water) hex")
+		return
+	}
+	
+	fmt.Printf("Finding path from (%d,%d) to (%d,%d)", from.Q, from.R, to.Q, to.R)
+	if *avoidWater {
+		fmt.Print(" avoiding water")
+	}
+	fmt.Println()
+	
+	path, found := grid.FindPath(from, to, passable)
+	if !found {
+		fmt.Println("No path exists between these hexes")
+		return
+	}
+	
+	coords := make([]string, len(path))
+	for i, coord := range path {
+		coords[i] = fmt.Sprintf("(%d,%d)", coord.Q, coord.R)
+	}
+	fmt.Printf("Path: %s\n", strings.Join(coords, " -> "))
+	fmt.Printf("Total steps: %d\n", len(path)-1)
+}
+
 func parseCoord(coordStr string) (hex.AxialCoord, error) {
 	parts := strings.Split(coordStr, ",")
 	if len(parts) != 2 {
@@ -248,7 +363,11 @@ func handleGenerateTerrain(args []string) {
 	output := fs.String("output", "terrain.json", "Output filename for JSON data")
 	topology := fs.String("topology", "region", "Topology type: region or world")
 	landRatio := fs.Float64("land-ratio", 0.29, "Target land percentage (0.0-1.0)")
-	seaLevel := fs.Float64("sea-level", 0.0, "Sea level in meters")
+	seaLevel := fs.Float64("sea-level", 0.0, "Sea level in meters (default: calibrated to --land-ratio)")
+	stream := fs.Bool("stream", false, "Write JSON lines one tile at a time (for very large maps; no stats; requires --sea-level)")
+	falloff := fs.String("falloff", "none", "Edge falloff shape: none, radial, square, or ridged")
+	falloffStrength := fs.Float64("falloff-strength", 1.0, "Steepness of the edge falloff")
+	heightmapFile := fs.String("heightmap", "", "Grayscale PNG to use instead of generated noise")
 	
 	fs.Parse(args)
 	
@@ -266,52 +385,85 @@ func handleGenerateTerrain(args []string) {
 		return
 	}
 	
+	shape, err := parseFalloff(*falloff)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
 	// Create grid
 	gridConfig := hex.GridConfig{Width: width, Height: height, Topology: topo}
 	grid := hex.NewGrid(gridConfig)
 	
 	// Configure terrain generation
 	terrainConfig := terrain.TerrainConfig{
-		Seed:        *seed,
-		SeaLevel:    *seaLevel,
-		LandRatio:   *landRatio,
-		NoiseParams: terrain.DefaultNoiseParameters(),
+		Seed:            *seed,
+		SeaLevel:        *seaLevel,
+		LandRatio:       *landRatio,
+		NoiseParams:     terrain.DefaultNoiseParameters(),
+		Falloff:         shape,
+		FalloffStrength: *falloffStrength,
 	}
 	
+	// World maps wrap, so their heightmap must too
+	terrainConfig.NoiseParams.Tileable = topo == hex.TopologyWorld
+	
 	fmt.Printf("Generating %dx%d terrain (seed: %d)...\n", width, height, *seed)
 	
-	// Generate terrain
-	tiles, err := terrain.GenerateTerrain(grid, terrainConfig)
+	if *stream {
+		if *heightmapFile != "" {
+			fmt.Println("Error: --stream cannot be combined with --heightmap")
+			return
+		}
+		// Calibrating to --land-ratio needs every tile in memory, which streaming avoids
+		if !flagWasSet(fs, "sea-level") {
+			fmt.Println("Error: --stream requires an explicit --sea-level")
+			return
+		}
+		if err := streamTerrainFile(*output, grid, terrainConfig); err != nil {
+			fmt.Printf("Error streaming terrain: %v\n", err)
+			return
+		}
+		fmt.Printf("Terrain streamed to %s\n", *output)
+		return
+	}
+	
+	// Generate terrain, from the imported heightmap if one was given
+	var tiles []*terrain.HexTile
+	if *heightmapFile != "" {
+		heightmap, err := export.ImportHeightmapPNG(*heightmapFile)
+		if err != nil {
+			fmt.Printf("Error reading heightmap: %v\n", err)
+			return
+		}
+		tiles, err = terrain.GenerateTerrainFromHeightmap(grid, heightmap, terrainConfig)
+	} else {
+		tiles, err = terrain.GenerateTerrain(grid, terrainConfig)
+	}
 	if err != nil {
 		fmt.Printf("Error generating terrain: %v\n", err)
 		return
 	}
 	
+	// Unless a sea level was given, pick the one that hits the land ratio exactly
+	if !flagWasSet(fs, "sea-level") {
+		terrainConfig.SeaLevel = terrain.CalibrateSeaLevel(tiles, *landRatio)
+		fmt.Printf("Calibrated sea level: %.1fm\n", terrainConfig.SeaLevel)
+	}
+	
 	// Calculate statistics
 	stats := terrain.ValidateTerrain(tiles)
 	
 	// Save to JSON
-	terrainData := struct {
-		Config terrain.TerrainConfig `json:"config"`
-		Stats  terrain.TerrainStats  `json:"stats"`
-		Tiles  []*terrain.HexTile    `json:"tiles"`
-	}{
+	terrainData := &terrain.TerrainFile{
 		Config: terrainConfig,
+		Grid:   terrain.NewGridInfo(grid),
 		Stats:  stats,
 		Tiles:  tiles,
 	}
 	
-	file, err := os.Create(*output)
-	if err != nil {
-		fmt.Printf("Error creating output file: %v\n", err)
-		return
-	}
-	defer file.Close()
-	
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(terrainData); err != nil {
-		fmt.Printf("Error encoding JSON: %v\n", err)
+	if err := terrain.SaveTerrainFile(*output, terrainData); err != nil {
+		fmt.Printf("Error saving terrain: %v\n", err)
 		return
 	}
 	
@@ -322,32 +474,247 @@ func handleGenerateTerrain(args []string) {
 		stats.ElevationRange[0], stats.ElevationRange[1])
 }
 
+// flagWasSet reports whether a flag was given explicitly on the command line
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// streamTerrainFile writes terrain to path as JSON lines without holding every tile
+func streamTerrainFile(path string, grid *hex.Grid, config terrain.TerrainConfig) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	
+	if err := terrain.StreamTerrain(file, grid, config); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func handleBatchGenerate(args []string) {
+	fs := flag.NewFlagSet("batch-generate", flag.ExitOnError)
+	size := fs.String("size", "100x100", "Grid size as WIDTHxHEIGHT")
+	seedList := fs.String("seeds", "1,2,3", "Comma-separated random seeds")
+	outputDir := fs.String("output-dir", ".", "Directory for terrain-SEED.json files")
+	topology := fs.String("topology", "region", "Topology type: region or world")
+	landRatio := fs.Float64("land-ratio", 0.29, "Target land percentage (0.0-1.0)")
+	
+	fs.Parse(args)
+	
+	width, height, err := parseSize(*size)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	topo, err := parseTopology(*topology)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	seeds, err := parseSeeds(*seedList)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		fmt.Printf("Error creating output directory: %v\n", err)
+		return
+	}
+	
+	grid := hex.NewGrid(hex.GridConfig{Width: width, Height: height, Topology: topo})
+	terrainConfig := terrain.TerrainConfig{
+		LandRatio:   *landRatio,
+		NoiseParams: terrain.DefaultNoiseParameters(),
+	}
+	terrainConfig.NoiseParams.Tileable = topo == hex.TopologyWorld
+	
+	if err := terrainConfig.Validate(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	fmt.Printf("Generating %d %dx%d terrains...\n", len(seeds), width, height)
+	results := terrain.GenerateBatch(grid, terrainConfig, seeds)
+	
+	for _, seed := range seeds {
+		tiles, ok := results[seed]
+		if !ok {
+			fmt.Printf("Error: seed %d failed to generate\n", seed)
+			continue
+		}
+		
+		seedConfig := terrainConfig
+		seedConfig.Seed = seed
+		seedConfig.SeaLevel = terrain.CalibrateSeaLevel(tiles, *landRatio)
+		stats := terrain.ValidateTerrain(tiles)
+		
+		path := filepath.Join(*outputDir, fmt.Sprintf("terrain-%d.json", seed))
+		terrainData := &terrain.TerrainFile{
+			Config: seedConfig,
+			Grid:   terrain.NewGridInfo(grid),
+			Stats:  stats,
+			Tiles:  tiles,
+		}
+		if err := terrain.SaveTerrainFile(path, terrainData); err != nil {
+			fmt.Printf("Error saving %s: %v\n", path, err)
+			continue
+		}
+		
+		fmt.Printf("  seed %d: %s (land %.1f%%, hypsometric match %.1f%%)\n",
+			seed, path, stats.LandPercentage, stats.HypsometricMatch*100)
+	}
+}
+
+func handleFindSeed(args []string) {
+	fs := flag.NewFlagSet("find-seed", flag.ExitOnError)
+	size := fs.String("size", "100x100", "Grid size as WIDTHxHEIGHT")
+	seedList := fs.String("seeds", "1,2,3,4,5,6,7,8,9,10", "Comma-separated random seeds to try")
+	topology := fs.String("topology", "region", "Topology type: region or world")
+	landRatio := fs.Float64("land-ratio", 0.29, "Target land percentage (0.0-1.0)")
+	
+	fs.Parse(args)
+	
+	width, height, err := parseSize(*size)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	topo, err := parseTopology(*topology)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	seeds, err := parseSeeds(*seedList)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	grid := hex.NewGrid(hex.GridConfig{Width: width, Height: height, Topology: topo})
+	terrainConfig := terrain.TerrainConfig{
+		LandRatio:   *landRatio,
+		NoiseParams: terrain.DefaultNoiseParameters(),
+	}
+	terrainConfig.NoiseParams.Tileable = topo == hex.TopologyWorld
+	
+	if err := terrainConfig.Validate(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	
+	fmt.Printf("Searching %d seeds on a %dx%d grid...\n", len(seeds), width, height)
+	seed, stats := terrain.FindRealisticSeed(grid, terrainConfig, seeds)
+	
+	fmt.Printf("Best seed: %d\n", seed)
+	fmt.Printf("  Hypsometric Match: %.1f%%\n", stats.HypsometricMatch*100)
+	fmt.Printf("  Land: %.1f%% (target %.1f%%)\n", stats.LandPercentage, *landRatio*100)
+	fmt.Printf("  Landmasses: %d (largest: %d tiles)\n", stats.LandmassCount, stats.LargestLandmass)
+}
+
+func handleAnimateSeaLevel(args []string) {
+	fs := flag.NewFlagSet("animate-sealevel", flag.ExitOnError)
+	input := fs.String("input", "terrain.json", "Terrain JSON file to animate")
+	output := fs.String("output", "sealevel.gif", "Output GIF filename")
+	from := fs.Float64("from", -100, "Starting sea level in meters")
+	to := fs.Float64("to", 100, "Final sea level in meters")
+	steps := fs.Int("steps", 20, "Number of frames")
+	hexSize := fs.Float64("hex-size", 6, "Hex radius in pixels")
+	delay := fs.Int("delay", 150, "Delay between frames in milliseconds")
+	
+	fs.Parse(args)
+	
+	if *steps < 1 {
+		fmt.Println("Error: steps must be at least 1")
+		return
+	}
+	if *hexSize <= 0 {
+		fmt.Println("Error: hex-size must be positive")
+		return
+	}
+	
+	grid, terrainData, err := terrain.LoadTerrainFile(*input)
+	if err != nil {
+		fmt.Printf("Error loading terrain: %v\n", err)
+		return
+	}
+	
+	frames := make([]*image.RGBA, 0, *steps)
+	for i := 0; i < *steps; i++ {
+		seaLevel := *from
+		if *steps > 1 {
+			seaLevel += (*to - *from) * float64(i) / float64(*steps-1)
+		}
+		frames = append(frames, export.RenderLandWater(terrainData.Tiles, grid, *hexSize, seaLevel))
+	}
+	
+	if err := export.ExportAnimatedGIF(frames, *output, *delay); err != nil {
+		fmt.Printf("Error writing animation: %v\n", err)
+		return
+	}
+	
+	fmt.Printf("Wrote %d frames (sea level %.1fm to %.1fm) to %s\n", *steps, *from, *to, *output)
+}
+
+func handleExportHeightmap(args []string) {
+	fs := flag.NewFlagSet("export-heightmap", flag.ExitOnError)
+	input := fs.String("input", "terrain.json", "Terrain JSON file to export")
+	output := fs.String("output", "heightmap.png", "Output PNG filename")
+	
+	fs.Parse(args)
+	
+	grid, terrainData, err := terrain.LoadTerrainFile(*input)
+	if err != nil {
+		fmt.Printf("Error loading terrain: %v\n", err)
+		return
+	}
+	
+	heightmap := terrain.HexTilesToHeightmap(terrainData.Tiles, grid)
+	if err := export.ExportHeightmapPNG(heightmap, *output); err != nil {
+		fmt.Printf("Error writing heightmap: %v\n", err)
+		return
+	}
+	
+	fmt.Printf("Wrote %dx%d heightmap to %s\n", len(heightmap[0]), len(heightmap), *output)
+}
+
 func handleTerrainStats(args []string) {
-	if len(args) == 0 {
+	fs := flag.NewFlagSet("terrain-stats", flag.ExitOnError)
+	jsonOutput := fs.Bool("json", false, "Print statistics as a single JSON object")
+	
+	fs.Parse(args)
+	
+	if len(fs.Args()) == 0 {
 		fmt.Println("Error: Please provide a terrain JSON file")
-		fmt.Println("Usage: hex-world terrain-stats FILE.json")
+		fmt.Println("Usage: hex-world terrain-stats [--json] FILE.json")
 		return
 	}
 	
-	filename := args[0]
+	filename := fs.Args()[0]
 	
 	// Load terrain data
-	file, err := os.Open(filename)
+	_, terrainData, err := terrain.LoadTerrainFile(filename)
 	if err != nil {
-		fmt.Printf("Error opening file: %v\n", err)
+		fmt.Printf("Error loading terrain: %v\n", err)
 		return
 	}
-	defer file.Close()
 	
-	var terrainData struct {
-		Config terrain.TerrainConfig `json:"config"`
-		Stats  terrain.TerrainStats  `json:"stats"`
-		Tiles  []*terrain.HexTile    `json:"tiles"`
-	}
-	
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&terrainData); err != nil {
-		fmt.Printf("Error decoding JSON: %v\n", err)
+	if *jsonOutput {
+		if err := writeTerrainStatsJSON(os.Stdout, terrainData.Stats); err != nil {
+			fmt.Printf("Error encoding JSON: %v\n", err)
+		}
 		return
 	}
 	
@@ -360,6 +727,8 @@ func handleTerrainStats(args []string) {
 	
 	fmt.Println("Generation Parameters:")
 	fmt.Printf("  Seed: %d\n", config.Seed)
+	fmt.Printf("  Grid: %dx%d (%s)\n", terrainData.Grid.Width, terrainData.Grid.Height,
+		topologyName(terrainData.Grid.Topology))
 	fmt.Printf("  Sea Level: %.1fm\n", config.SeaLevel)
 	fmt.Printf("  Target Land Ratio: %.1f%%\n", config.LandRatio*100)
 	fmt.Printf("  Noise Octaves: %d\n", config.NoiseParams.Octaves)
@@ -376,9 +745,11 @@ func handleTerrainStats(args []string) {
 	fmt.Printf("  Total Tiles: %d\n", stats.TotalTiles)
 	fmt.Printf("  Land: %d tiles (%.1f%%)\n", stats.LandTiles, stats.LandPercentage)
 	fmt.Printf("  Water: %d tiles (%.1f%%)\n", stats.WaterTiles, stats.WaterPercentage)
+	fmt.Printf("  Landmasses: %d (largest: %d tiles)\n", stats.LandmassCount, stats.LargestLandmass)
 	
 	fmt.Println("\nQuality Metrics:")
 	fmt.Printf("  Hypsometric Match: %.1f%% (Earth-like curve)\n", stats.HypsometricMatch*100)
+	fmt.Printf("  Quality Score: %.2f\n", terrain.ComputeQualityScore(stats))
 	
 	// Check realism
 	isRealistic, issues := terrain.IsRealisticTerrain(stats)
@@ -392,35 +763,141 @@ func handleTerrainStats(args []string) {
 	}
 }
 
-func handleValidateTerrain(args []string) {
-	fs := flag.NewFlagSet("validate-terrain", flag.ExitOnError)
-	strict := fs.Bool("strict", false, "Use strict validation criteria")
+// terrainStatsReport is the machine-readable output of terrain-stats --json
+type terrainStatsReport struct {
+	Stats     terrain.TerrainStats `json:"stats"`
+	Realistic bool                 `json:"realistic"`
+	Issues    []string             `json:"issues"`
+}
+
+// writeTerrainStatsJSON writes terrain statistics and realism issues as JSON
+func writeTerrainStatsJSON(w io.Writer, stats terrain.TerrainStats) error {
+	realistic, issues := terrain.IsRealisticTerrain(stats)
+	if issues == nil {
+		issues = []string{}
+	}
+	
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(terrainStatsReport{
+		Stats:     stats,
+		Realistic: realistic,
+		Issues:    issues,
+	})
+}
+
+func handleDiffTerrain(args []string) {
+	if len(args) != 2 {
+		fmt.Println("Error: Please provide two terrain JSON files")
+		fmt.Println("Usage: hex-world diff-terrain A.json B.json")
+		return
+	}
+	
+	_, dataA, err := terrain.LoadTerrainFile(args[0])
+	if err != nil {
+		fmt.Printf("Error loading %s: %v\n", args[0], err)
+		return
+	}
+	
+	_, dataB, err := terrain.LoadTerrainFile(args[1])
+	if err != nil {
+		fmt.Printf("Error loading %s: %v\n", args[1], err)
+		return
+	}
+	
+	diff := terrain.DiffTerrain(dataA.Tiles, dataB.Tiles)
+	
+	fmt.Printf("Terrain Diff: %s -> %s\n", args[0], args[1])
+	fmt.Println(strings.Repeat("=", 50))
+	fmt.Printf("  Seeds: %d -> %d\n", dataA.Config.Seed, dataB.Config.Seed)
+	fmt.Printf("  Tiles compared: %d (unmatched: %d)\n", diff.Compared, diff.Unmatched)
+	
+	fmt.Println("\nElevation Changes:")
+	fmt.Printf("  Max |delta|: %.1fm\n", diff.MaxDelta)
+	fmt.Printf("  Mean delta: %.1fm\n", diff.MeanDelta)
+	fmt.Printf("  RMS delta: %.1fm\n", diff.RMSDelta)
+	
+	fmt.Println("\nLand/Water Changes:")
+	flippedPct := 0.0
+	if diff.Compared > 0 {
+		flippedPct = float64(diff.Flipped) / float64(diff.Compared) * 100
+	}
+	fmt.Printf("  Flipped: %d tiles (%.1f%%)\n", diff.Flipped, flippedPct)
+	fmt.Printf("  Land -> water: %d\n", diff.LandToWater)
+	fmt.Printf("  Water -> land: %d\n", diff.WaterToLand)
+}
+
+func handleHistogram(args []string) {
+	fs := flag.NewFlagSet("histogram", flag.ExitOnError)
+	bins := fs.Int("bins", 20, "Number of elevation bins")
 	
 	fs.Parse(args)
 	
 	if len(fs.Args()) == 0 {
 		fmt.Println("Error: Please provide a terrain JSON file")
-		fmt.Println("Usage: hex-world validate-terrain FILE.json [--strict]")
+		fmt.Println("Usage: hex-world histogram [--bins=N] FILE.json")
+		return
+	}
+	
+	if *bins < 1 {
+		fmt.Println("Error: bins must be at least 1")
 		return
 	}
 	
 	filename := fs.Args()[0]
 	
-	// Load terrain data
-	file, err := os.Open(filename)
+	_, terrainData, err := terrain.LoadTerrainFile(filename)
 	if err != nil {
-		fmt.Printf("Error opening file: %v\n", err)
+		fmt.Printf("Error loading terrain: %v\n", err)
 		return
 	}
-	defer file.Close()
 	
-	var terrainData struct {
-		Tiles []*terrain.HexTile `json:"tiles"`
+	edges, counts := terrain.ElevationHistogram(terrainData.Tiles, *bins)
+	if counts == nil {
+		fmt.Println("Error: terrain has no tiles")
+		return
+	}
+	
+	fmt.Printf("Elevation Histogram for %s\n", filename)
+	fmt.Println(strings.Repeat("=", 50))
+	writeHistogram(os.Stdout, edges, counts, 40)
+}
+
+// writeHistogram draws one bar per bin, scaled so the largest bin is barWidth wide
+func writeHistogram(w io.Writer, edges []float64, counts []int, barWidth int) {
+	largest := 0
+	for _, count := range counts {
+		largest = max(largest, count)
+	}
+	
+	for i, count := range counts {
+		bar := 0
+		if largest > 0 {
+			bar = count * barWidth / largest
+		}
+		fmt.Fprintf(w, "%8.0fm to %8.0fm | %-*s %d\n",
+			edges[i], edges[i+1], barWidth, strings.Repeat("#", bar), count)
+	}
+}
+
+func handleValidateTerrain(args []string) {
+	fs := flag.NewFlagSet("validate-terrain", flag.ExitOnError)
+	strict := fs.Bool("strict", false, "Use strict validation criteria")
+	
+	fs.Parse(args)
+	
+	if len(fs.Args()) == 0 {
+		fmt.Println("Error: Please provide a terrain JSON file")
+		fmt.Println("Usage: hex-world validate-terrain FILE.json [--strict]")
+		return
 	}
 	
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&terrainData); err != nil {
-		fmt.Printf("Error decoding JSON: %v\n", err)
+	filename := fs.Args()[0]
+	
+	// Load terrain data
+	grid, terrainData, err := terrain.LoadTerrainFile(filename)
+	if err != nil {
+		fmt.Printf("Error loading terrain: %v\n", err)
 		return
 	}
 	
@@ -433,11 +910,12 @@ func handleValidateTerrain(args []string) {
 	
 	// Detect anomalies
 	anomalies := terrain.DetectElevationAnomalies(terrainData.Tiles)
+	artifacts := terrain.DetectGridArtifacts(terrainData.Tiles, grid)
 	
 	// Report results
 	fmt.Printf("Total tiles validated: %d\n", len(terrainData.Tiles))
 	
-	if isRealistic && len(anomalies) == 0 {
+	if isRealistic && len(anomalies) == 0 && len(artifacts) == 0 {
 		fmt.Println("Status: ✅ VALID - Terrain passes all realism checks")
 	} else {
 		fmt.Println("Status: ❌ INVALID - Issues detected")
@@ -455,6 +933,13 @@ func handleValidateTerrain(args []string) {
 				fmt.Printf("  - %s\n", anomaly)
 			}
 		}
+		
+		if len(artifacts) > 0 {
+			fmt.Println("\nGrid Artifacts:")
+			for _, artifact := range artifacts {
+				fmt.Printf("  - %s\n", artifact)
+			}
+		}
 	}
 	
 	// In strict mode, additional checks
@@ -590,6 +1075,28 @@ func parseSize(sizeStr string) (int, int, error) {
 	return width, height, nil
 }
 
+func parseSeeds(seedsStr string) ([]int64, error) {
+	var seeds []int64
+	for _, part := range strings.Split(seedsStr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		
+		seed, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed '%s'", part)
+		}
+		seeds = append(seeds, seed)
+	}
+	
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("at least one seed is required")
+	}
+	
+	return seeds, nil
+}
+
 func parseTopology(topologyStr string) (hex.Topology, error) {
 	switch topologyStr {
 	case "region":
@@ -599,4 +1106,39 @@ func parseTopology(topologyStr string) (hex.Topology, error) {
 	default:
 		return hex.TopologyRegion, fmt.Errorf("unknown topology '%s'. Use 'region' or 'world'", topologyStr)
 	}
+}
+
+func parseOrientation(orientationStr string) (hex.Orientation, error) {
+	switch orientationStr {
+	case "flat":
+		return hex.FlatTop, nil
+	case "pointy":
+		return hex.PointyTop, nil
+	default:
+		return hex.FlatTop, fmt.Errorf("unknown orientation '%s'. Use 'flat' or 'pointy'", orientationStr)
+	}
+}
+
+// parseFalloff parses an edge falloff shape name
+func parseFalloff(falloffStr string) (terrain.FalloffShape, error) {
+	switch falloffStr {
+	case "none":
+		return terrain.FalloffNone, nil
+	case "radial":
+		return terrain.FalloffRadial, nil
+	case "square":
+		return terrain.FalloffSquareBump, nil
+	case "ridged":
+		return terrain.FalloffRidged, nil
+	default:
+		return terrain.FalloffNone, fmt.Errorf("unknown falloff '%s'. Use 'none', 'radial', 'square', or 'ridged'", falloffStr)
+	}
+}
+
+// topologyName returns the CLI name of a topology
+func topologyName(topology hex.Topology) string {
+	if topology == hex.TopologyWorld {
+		return "world"
+	}
+	return "region"
 }
\ No newline at end of file
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestWriteTerrainStatsJSON(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 20, Height: 15, Topology: hex.TopologyRegion})
+	config := terrain.DefaultTerrainConfig()
+	tiles, err := terrain.GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	// Round trip through a saved file, as terrain-stats does
+	path := filepath.Join(t.TempDir(), "terrain.json")
+	saved := &terrain.TerrainFile{
+		Config: config,
+		Grid:   terrain.NewGridInfo(grid),
+		Stats:  terrain.ValidateTerrain(tiles),
+		Tiles:  tiles,
+	}
+	if err := terrain.SaveTerrainFile(path, saved); err != nil {
+		t.Fatalf("SaveTerrainFile() failed: %v", err)
+	}
+	_, loaded, err := terrain.LoadTerrainFile(path)
+	if err != nil {
+		t.Fatalf("LoadTerrainFile() failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeTerrainStatsJSON(&buf, loaded.Stats); err != nil {
+		t.Fatalf("writeTerrainStatsJSON() failed: %v", err)
+	}
+
+	var report struct {
+		Stats     terrain.TerrainStats `json:"stats"`
+		Realistic *bool                `json:"realistic"`
+		Issues    []string             `json:"issues"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, buf.String())
+	}
+
+	if report.Stats.TotalTiles != 300 {
+		t.Errorf("Expected 300 total tiles, got %d", report.Stats.TotalTiles)
+	}
+	if report.Realistic == nil || report.Issues == nil {
+		t.Errorf("Expected realistic and issues fields, got %s", buf.String())
+	}
+
+	realistic, issues := terrain.IsRealisticTerrain(loaded.Stats)
+	if *report.Realistic != realistic || len(report.Issues) != len(issues) {
+		t.Errorf("Report (%v, %v) doesn't match IsRealisticTerrain (%v, %v)",
+			*report.Realistic, report.Issues, realistic, issues)
+	}
+}
+
+func TestParseOrientation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected hex.Orientation
+		wantErr  bool
+	}{
+		{"flat", hex.FlatTop, false},
+		{"pointy", hex.PointyTop, false},
+		{"Pointy", hex.FlatTop, true},
+		{"", hex.FlatTop, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseOrientation(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseOrientation(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if got != tt.expected {
+			t.Errorf("parseOrientation(%q) = %v, expected %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestParseFalloff(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected terrain.FalloffShape
+		wantErr  bool
+	}{
+		{"none", terrain.FalloffNone, false},
+		{"radial", terrain.FalloffRadial, false},
+		{"square", terrain.FalloffSquareBump, false},
+		{"ridged", terrain.FalloffRidged, false},
+		{"island", terrain.FalloffNone, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFalloff(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseFalloff(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if got != tt.expected {
+			t.Errorf("parseFalloff(%q) = %v, expected %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestWriteHistogram(t *testing.T) {
+	var buf bytes.Buffer
+	writeHistogram(&buf, []float64{0, 100, 200}, []int{2, 4}, 10)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d:\n%s", len(lines), buf.String())
+	}
+	if strings.Count(lines[0], "#") != 5 || strings.Count(lines[1], "#") != 10 {
+		t.Errorf("Bars not scaled to the largest bin:\n%s", buf.String())
+	}
+}
+
+func TestParseSeeds(t *testing.T) {
+	seeds, err := parseSeeds("1, 2,-3,,42")
+	if err != nil {
+		t.Fatalf("parseSeeds() failed: %v", err)
+	}
+	expected := []int64{1, 2, -3, 42}
+	if len(seeds) != len(expected) {
+		t.Fatalf("parseSeeds() = %v, expected %v", seeds, expected)
+	}
+	for i := range seeds {
+		if seeds[i] != expected[i] {
+			t.Errorf("parseSeeds() = %v, expected %v", seeds, expected)
+			break
+		}
+	}
+
+	for _, bad := range []string{"", ",", "1,x", "1.5"} {
+		if _, err := parseSeeds(bad); err == nil {
+			t.Errorf("parseSeeds(%q) should fail", bad)
+		}
+	}
+}
+
+func TestGenerateTerrainStream(t *testing.T) {
+	dir := t.TempDir()
+
+	// Streaming can't calibrate sea level, so it needs one given explicitly
+	uncalibrated := filepath.Join(dir, "uncalibrated.jsonl")
+	handleGenerateTerrain([]string{"--size=12x8", "--stream", "--output=" + uncalibrated})
+	if _, err := os.Stat(uncalibrated); !os.IsNotExist(err) {
+		t.Errorf("Expected no stream without --sea-level, got stat error %v", err)
+	}
+
+	path := filepath.Join(dir, "terrain.jsonl")
+	handleGenerateTerrain([]string{"--size=12x8", "--stream", "--sea-level=150", "--output=" + path})
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Expected streamed terrain file: %v", err)
+	}
+	defer file.Close()
+
+	reader, err := terrain.NewTerrainStreamReader(file)
+	if err != nil {
+		t.Fatalf("NewTerrainStreamReader failed: %v", err)
+	}
+	if reader.Header().Config.SeaLevel != 150 {
+		t.Errorf("Expected sea level 150 in stream header, got %f", reader.Header().Config.SeaLevel)
+	}
+}
@@ -2,7 +2,9 @@ package noise
 
 import (
 	"math"
+	"math/cmplx"
 	"math/rand"
+	"sync"
 )
 
 // DiamondSquare generates fractal terrain using the Diamond-Square algorithm
@@ -117,10 +119,26 @@ func isPowerOfTwoPlusOne(n int) bool {
 	return n > 0 && (n&(n-1)) == 0
 }
 
-// MultiOctaveNoise combines multiple octaves of Diamond-Square noise
+// MultiOctaveNoise combines multiple octaves of Diamond-Square noise.
+// The first octave's field is stretched across the larger target dimension and
+// each later octave samples its field lacunarity times more densely. Fields
+// use a periodic lattice and bilinear interpolation, so repeats have no seams.
+// scale is accepted for signature compatibility with the other noise functions
 func MultiOctaveNoise(width, height int, octaves int, persistence, lacunarity, scale float64, seed int64) [][]float64 {
-	// Find the smallest power-of-two-plus-one size that fits our target
-	noiseSize := nextPowerOfTwoPlusOne(max(width, height))
+	return multiOctaveNoise(width, height, octaves, persistence, lacunarity, seed, false)
+}
+
+// TileableMultiOctaveNoise is MultiOctaveNoise that wraps seamlessly in both
+// directions. Each octave's repeat count is rounded per axis to a whole
+// number of periods across the output, as TileablePerlinNoise rounds its scale
+func TileableMultiOctaveNoise(width, height int, octaves int, persistence, lacunarity, scale float64, seed int64) [][]float64 {
+	return multiOctaveNoise(width, height, octaves, persistence, lacunarity, seed, true)
+}
+
+// multiOctaveNoise sums Diamond-Square octaves, optionally rounded to tile
+func multiOctaveNoise(width, height int, octaves int, persistence, lacunarity float64, seed int64, tileable bool) [][]float64 {
+	// Find the smallest power-of-two period that fits our target
+	period := octavePeriod(width, height)
 	
 	result := make([][]float64, height)
 	for i := range result {
@@ -128,45 +146,182 @@ func MultiOctaveNoise(width, height int, octaves int, persistence, lacunarity, s
 	}
 	
 	amplitude := 1.0
-	frequency := scale
+	frequency := 1.0
 	maxValue := 0.0
 	
 	for octave := 0; octave < octaves; octave++ {
-		// Generate noise for this octave
-		octaveSeed := seed + int64(octave*1000)
-		octaveNoise := DiamondSquare(noiseSize, 0.5, octaveSeed)
+		// Generate noise for this octave and add it to the result
+		octaveNoise := periodicDiamondSquare(period, 0.5, octaveSeed(seed, octave))
+		addOctave(result, octaveNoise, frequency, amplitude, tileable)
 		
-		// Add this octave to the result
-		for y := 0; y < height; y++ {
-			for x := 0; x < width; x++ {
-				// Sample from the noise using frequency scaling
-				noiseX := int(float64(x) * frequency) % noiseSize
-				noiseY := int(float64(y) * frequency) % noiseSize
+		maxValue += amplitude
+		amplitude *= persistence
+		frequency *= lacunarity
+	}
+	
+	normalizeOctaves(result, maxValue)
+	
+	return result
+}
+
+// MultiOctaveNoiseParallel is a parallel variant of MultiOctaveNoise that
+// generates each octave in its own goroutine. Every octave uses an independent
+// RNG stream and octaves are summed in the same fixed order as the serial
+// version, so the output is bit-for-bit identical to MultiOctaveNoise
+func MultiOctaveNoiseParallel(width, height int, octaves int, persistence, lacunarity, scale float64, seed int64) [][]float64 {
+	period := octavePeriod(width, height)
+	
+	// Generate all octave fields concurrently
+	octaveFields := make([][][]float64, octaves)
+	var wg sync.WaitGroup
+	for octave := 0; octave < octaves; octave++ {
+		wg.Add(1)
+		go func(octave int) {
+			defer wg.Done()
+			octaveFields[octave] = periodicDiamondSquare(period, 0.5, octaveSeed(seed, octave))
+		}(octave)
+	}
+	wg.Wait()
+	
+	result := make([][]float64, height)
+	for i := range result {
+		result[i] = make([]float64, width)
+	}
+	
+	amplitude := 1.0
+	frequency := 1.0
+	maxValue := 0.0
+	
+	// Sum serially in octave order so floating-point results match exactly
+	for octave := 0; octave < octaves; octave++ {
+		addOctave(result, octaveFields[octave], frequency, amplitude, false)
+		
+		maxValue += amplitude
+		amplitude *= persistence
+		frequency *= lacunarity
+	}
+	
+	normalizeOctaves(result, maxValue)
+	
+	return result
+}
+
+// octaveSeed derives the RNG seed for a single octave by hashing the base seed
+// with the octave index, so nearby base seeds never share octave seeds
+func octaveSeed(seed int64, octave int) int64 {
+	return int64(splitmix64(uint64(seed) ^ splitmix64(uint64(octave))))
+}
+
+// octavePeriod returns the power-of-two lattice period of the octave fields
+// for a width x height result
+func octavePeriod(width, height int) int {
+	return max(2, nextPowerOfTwo(max(width, height)))
+}
+
+// periodicDiamondSquare generates a period x period Diamond-Square field whose
+// lattice wraps at the edges, so sampling past one side continues from the
+// other without a seam. period must be a power of two
+func periodicDiamondSquare(period int, roughness float64, seed int64) [][]float64 {
+	rng := rand.New(rand.NewSource(seed))
+	heightmap := make([][]float64, period)
+	for i := range heightmap {
+		heightmap[i] = make([]float64, period)
+	}
+	wrap := func(i int) int { return wrapIndex(i, period) }
+	
+	// The four corners coincide once the lattice wraps
+	heightmap[0][0] = rng.Float64()*2 - 1
+	
+	stepSize := period
+	scale := roughness
+	
+	for stepSize > 1 {
+		halfStep := stepSize / 2
+		
+		// Diamond step: set center points of squares
+		for y := halfStep; y < period; y += stepSize {
+			for x := halfStep; x < period; x += stepSize {
+				avg := (heightmap[y-halfStep][x-halfStep] +
+					heightmap[y-halfStep][wrap(x+halfStep)] +
+					heightmap[wrap(y+halfStep)][x-halfStep] +
+					heightmap[wrap(y+halfStep)][wrap(x+halfStep)]) / 4.0
 				
-				if noiseX < 0 {
-					noiseX += noiseSize
-				}
-				if noiseY < 0 {
-					noiseY += noiseSize
-				}
+				heightmap[y][x] = avg + (rng.Float64()*2-1)*scale
+			}
+		}
+		
+		// Square step: set center points of diamonds, wrapping every neighbor
+		for y := 0; y < period; y += halfStep {
+			for x := (y+halfStep)%stepSize; x < period; x += stepSize {
+				avg := (heightmap[wrap(y-halfStep)][x] +
+					heightmap[wrap(y+halfStep)][x] +
+					heightmap[y][wrap(x-halfStep)] +
+					heightmap[y][wrap(x+halfStep)]) / 4.0
 				
-				result[y][x] += octaveNoise[noiseY][noiseX] * amplitude
+				heightmap[y][x] = avg + (rng.Float64()*2-1)*scale
 			}
 		}
 		
-		maxValue += amplitude
-		amplitude *= persistence
-		frequency *= lacunarity
+		stepSize /= 2
+		scale *= roughness
+	}
+	
+	return heightmap
+}
+
+// addOctave samples a periodic octave field and adds it to the result.
+// At frequency 1 the field spans the larger of the result's dimensions. When
+// tileable, the number of periods across each axis is rounded to a whole
+// number so the octave wraps at the result's edges
+func addOctave(result, octaveNoise [][]float64, frequency, amplitude float64, tileable bool) {
+	if len(result) == 0 || len(result[0]) == 0 {
+		return
+	}
+	
+	width, height := len(result[0]), len(result)
+	span := float64(max(width, height))
+	periodsX := frequency * float64(width) / span
+	periodsY := frequency * float64(height) / span
+	if tileable {
+		periodsX = math.Max(1, math.Round(periodsX))
+		periodsY = math.Max(1, math.Round(periodsY))
+	}
+	
+	period := float64(len(octaveNoise))
+	stepX := periodsX * period / float64(width)
+	stepY := periodsY * period / float64(height)
+	
+	for y := range result {
+		for x := range result[y] {
+			result[y][x] += samplePeriodic(octaveNoise, float64(x)*stepX, float64(y)*stepY) * amplitude
+		}
 	}
+}
+
+// samplePeriodic bilinearly interpolates a square periodic field, wrapping
+// the position and its neighboring lattice points into the field
+func samplePeriodic(data [][]float64, x, y float64) float64 {
+	period := len(data)
 	
+	x0f, y0f := math.Floor(x), math.Floor(y)
+	x0, y0 := wrapIndex(int(x0f), period), wrapIndex(int(y0f), period)
+	x1, y1 := (x0+1)%period, (y0+1)%period
+	tx, ty := x-x0f, y-y0f
+	
+	top := lerp(data[y0][x0], data[y0][x1], tx)
+	bottom := lerp(data[y1][x0], data[y1][x1], tx)
+	
+	return lerp(top, bottom, ty)
+}
+
+// normalizeOctaves divides the summed octaves by the total amplitude
+func normalizeOctaves(result [][]float64, maxValue float64) {
 	// Normalize to [-1, 1] range
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
+	for y := range result {
+		for x := range result[y] {
 			result[y][x] /= maxValue
 		}
 	}
-	
-	return result
 }
 
 // nextPowerOfTwoPlusOne finds the smallest (2^n + 1) >= size
@@ -198,51 +353,75 @@ func max(a, b int) int {
 
 // SpectralSynthesis generates terrain using spectral synthesis with power law
 // Beta controls the power spectrum: β ≈ 2 gives realistic terrain
+// The spectrum is built with conjugate symmetry and transformed with a 2D FFT,
+// so generation is O(N log N); dimensions are padded to powers of two
 func SpectralSynthesis(width, height int, beta float64, seed int64) [][]float64 {
 	rng := rand.New(rand.NewSource(seed))
 	
-	// Create frequency domain representation
-	freqWidth := width / 2
-	freqHeight := height / 2
+	// FFT dimensions must be powers of two
+	fftWidth := nextPowerOfTwo(width)
+	fftHeight := nextPowerOfTwo(height)
 	
-	result := make([][]float64, height)
-	for i := range result {
-		result[i] = make([]float64, width)
+	spectrum := make([][]complex128, fftHeight)
+	for i := range spectrum {
+		spectrum[i] = make([]complex128, fftWidth)
 	}
 	
 	// Generate in frequency domain
-	for fy := 0; fy < freqHeight; fy++ {
-		for fx := 0; fx < freqWidth; fx++ {
+	for ky := 0; ky < fftHeight; ky++ {
+		for kx := 0; kx < fftWidth; kx++ {
+			// Conjugate partner of this frequency; real output requires
+			// F(-k) = conj(F(k))
+			py := (fftHeight - ky) % fftHeight
+			px := (fftWidth - kx) % fftWidth
+			if py*fftWidth+px < ky*fftWidth+kx {
+				spectrum[ky][kx] = cmplx.Conj(spectrum[py][px])
+				continue
+			}
+			
+			// Signed frequency components
+			fx := float64(kx)
+			if kx > fftWidth/2 {
+				fx -= float64(fftWidth)
+			}
+			fy := float64(ky)
+			if ky > fftHeight/2 {
+				fy -= float64(fftHeight)
+			}
+			
 			// Calculate frequency magnitude
-			freq := math.Sqrt(float64(fx*fx + fy*fy))
+			freq := math.Sqrt(fx*fx + fy*fy)
 			if freq == 0 {
-				freq = 1 // Avoid division by zero
+				continue // No DC offset
 			}
 			
 			// Power law amplitude: A(f) = 1/f^(β/2)
 			amplitude := 1.0 / math.Pow(freq, beta/2.0)
 			
-			// Random phase
+			// Random phase; self-conjugate frequencies must be real
 			phase := rng.Float64() * 2 * math.Pi
-			
-			// Generate spatial domain value (simplified inverse FFT)
-			for y := 0; y < height; y++ {
-				for x := 0; x < width; x++ {
-					spatial := 2*math.Pi*(float64(fx*x)/float64(width) + float64(fy*y)/float64(height))
-					result[y][x] += amplitude * math.Cos(spatial + phase)
-				}
+			if py == ky && px == kx {
+				spectrum[ky][kx] = complex(amplitude*math.Cos(phase), 0)
+			} else {
+				spectrum[ky][kx] = cmplx.Rect(amplitude, phase)
 			}
 		}
 	}
 	
-	// Normalize to [-1, 1]
-	minVal, maxVal := findMinMax(result)
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
-			result[y][x] = 2*(result[y][x]-minVal)/(maxVal-minVal) - 1
+	// Inverse FFT to the spatial domain
+	fft2D(spectrum, true)
+	
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		for x := range result[y] {
+			result[y][x] = real(spectrum[y][x])
 		}
 	}
 	
+	// Normalize to [-1, 1]
+	normalizeToUnitRange(result)
+	
 	return result
 }
 
@@ -2,6 +2,7 @@ package noise
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -156,6 +157,159 @@ func TestMultiOctaveNoise(t *testing.T) {
 	}
 }
 
+func TestMultiOctaveNoiseParallelMatchesSerial(t *testing.T) {
+	width, height := 37, 23
+	octaves := 6
+	persistence := 0.5
+	lacunarity := 2.0
+	scale := 0.37
+	
+	for seed := int64(0); seed < 50; seed++ {
+		serial := MultiOctaveNoise(width, height, octaves, persistence, lacunarity, scale, seed)
+		parallel := MultiOctaveNoiseParallel(width, height, octaves, persistence, lacunarity, scale, seed)
+		
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				if serial[y][x] != parallel[y][x] {
+					t.Fatalf("Seed %d: parallel output differs at (%d,%d): %v vs %v",
+						seed, x, y, serial[y][x], parallel[y][x])
+				}
+			}
+		}
+	}
+}
+
+func TestMultiOctaveNoiseSmooth(t *testing.T) {
+	// Wide and tall grids should both sample smoothly without blocky jumps
+	sizes := [][2]int{{200, 50}, {50, 200}, {100, 100}}
+
+	for _, size := range sizes {
+		width, height := size[0], size[1]
+		result := MultiOctaveNoise(width, height, 4, 0.5, 2.0, 0.01, 42)
+
+		maxDelta := 0.0
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				if x+1 < width {
+					maxDelta = math.Max(maxDelta, math.Abs(result[y][x+1]-result[y][x]))
+				}
+				if y+1 < height {
+					maxDelta = math.Max(maxDelta, math.Abs(result[y+1][x]-result[y][x]))
+				}
+			}
+		}
+
+		if maxDelta > 0.25 {
+			t.Errorf("%dx%d: max neighbor delta %f suggests discontinuities", width, height, maxDelta)
+		}
+	}
+}
+
+func TestPeriodicDiamondSquare(t *testing.T) {
+	field := periodicDiamondSquare(64, 0.5, 42)
+	if len(field) != 64 || len(field[0]) != 64 {
+		t.Fatalf("Expected 64x64 field, got %dx%d", len(field[0]), len(field))
+	}
+
+	// The lattice wraps, so the edges join as smoothly as the interior
+	seam, interior := seamDeltas(field)
+	if seam > interior {
+		t.Errorf("Seam delta %f exceeds interior neighbor delta %f", seam, interior)
+	}
+
+	// Samples one period apart repeat exactly instead of mirroring
+	for _, p := range [][2]float64{{3.25, 7.5}, {40.75, 0.5}, {63.5, 63.5}} {
+		want := samplePeriodic(field, p[0], p[1])
+		if got := samplePeriodic(field, p[0]+64, p[1]-128); math.Abs(got-want) > 1e-12 {
+			t.Errorf("Sample at %v repeated as %f, expected %f", p, got, want)
+		}
+	}
+}
+
+func TestTileableMultiOctaveNoise(t *testing.T) {
+	for _, size := range [][2]int{{96, 48}, {50, 70}} {
+		width, height := size[0], size[1]
+		result := TileableMultiOctaveNoise(width, height, 5, 0.5, 2.0, 0.01, 42)
+
+		seam, interior := seamDeltas(result)
+		if seam > interior {
+			t.Errorf("%dx%d: seam delta %f exceeds interior neighbor delta %f", width, height, seam, interior)
+		}
+	}
+
+	// A non-integer lacunarity still wraps, since periods round per octave
+	result := TileableMultiOctaveNoise(64, 32, 4, 0.5, 1.7, 0.01, 42)
+	if seam, interior := seamDeltas(result); seam > interior {
+		t.Errorf("Lacunarity 1.7: seam delta %f exceeds interior neighbor delta %f", seam, interior)
+	}
+}
+
+func TestOctaveSeedsDoNotAlias(t *testing.T) {
+	// Under additive seeding, seed 42's second octave reused seed 1042's first.
+	// Fields are compared by their fine detail, since the few coarse midpoint
+	// offsets make raw fields of unrelated seeds correlate by chance
+	fields := map[string][][]float64{
+		"42/0":   DiamondSquare(65, 0.5, octaveSeed(42, 0)),
+		"42/1":   DiamondSquare(65, 0.5, octaveSeed(42, 1)),
+		"1042/0": DiamondSquare(65, 0.5, octaveSeed(1042, 0)),
+	}
+
+	pairs := [][2]string{{"42/0", "1042/0"}, {"42/1", "1042/0"}}
+	for _, pair := range pairs {
+		r := correlation(laplacian(fields[pair[0]]), laplacian(fields[pair[1]]))
+		if math.Abs(r) > 0.2 {
+			t.Errorf("Octave fields %s and %s are correlated: r = %f", pair[0], pair[1], r)
+		}
+	}
+
+	seen := make(map[int64]bool)
+	for seed := int64(0); seed < 100; seed++ {
+		for octave := 0; octave < 10; octave++ {
+			s := octaveSeed(seed, octave)
+			if seen[s] {
+				t.Fatalf("Octave seed collision at seed %d octave %d", seed, octave)
+			}
+			seen[s] = true
+		}
+	}
+}
+
+// laplacian returns the discrete Laplacian of a field's interior
+func laplacian(data [][]float64) [][]float64 {
+	result := make([][]float64, len(data)-2)
+	for y := 1; y < len(data)-1; y++ {
+		result[y-1] = make([]float64, len(data[y])-2)
+		for x := 1; x < len(data[y])-1; x++ {
+			result[y-1][x-1] = 4*data[y][x] - data[y-1][x] - data[y+1][x] - data[y][x-1] - data[y][x+1]
+		}
+	}
+	return result
+}
+
+// correlation returns the Pearson correlation coefficient of two equal-sized fields
+func correlation(a, b [][]float64) float64 {
+	var n, sumA, sumB float64
+	for y := range a {
+		for x := range a[y] {
+			sumA += a[y][x]
+			sumB += b[y][x]
+			n++
+		}
+	}
+	meanA, meanB := sumA/n, sumB/n
+
+	var cov, varA, varB float64
+	for y := range a {
+		for x := range a[y] {
+			da, db := a[y][x]-meanA, b[y][x]-meanB
+			cov += da * db
+			varA += da * da
+			varB += db * db
+		}
+	}
+	return cov / math.Sqrt(varA*varB)
+}
+
 func TestNextPowerOfTwoPlusOne(t *testing.T) {
 	tests := []struct {
 		input int
@@ -355,6 +509,20 @@ func BenchmarkMultiOctaveNoise(b *testing.B) {
 	}
 }
 
+func BenchmarkMultiOctaveNoiseParallel(b *testing.B) {
+	width, height := 100, 100
+	octaves := 6
+	persistence := 0.5
+	lacunarity := 2.0
+	scale := 0.01
+	seed := int64(42)
+	
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MultiOctaveNoiseParallel(width, height, octaves, persistence, lacunarity, scale, seed)
+	}
+}
+
 func BenchmarkSpectralSynthesis(b *testing.B) {
 	width, height := 64, 64
 	beta := 2.0
@@ -364,4 +532,58 @@ func BenchmarkSpectralSynthesis(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		SpectralSynthesis(width, height, beta, seed)
 	}
-}
\ No newline at end of file
+}
+
+func BenchmarkSpectralSynthesis256(b *testing.B) {
+	width, height := 256, 256
+	beta := 2.0
+	seed := int64(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SpectralSynthesis(width, height, beta, seed)
+	}
+}
+
+// BenchmarkSpectralSynthesisDirect measures the previous direct summation
+// approach at 64x64 for comparison with BenchmarkSpectralSynthesis
+func BenchmarkSpectralSynthesisDirect(b *testing.B) {
+	width, height := 64, 64
+	beta := 2.0
+	seed := int64(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		spectralSynthesisDirect(width, height, beta, seed)
+	}
+}
+
+// spectralSynthesisDirect sums every cosine term at every pixel, O(N⁴)
+func spectralSynthesisDirect(width, height int, beta float64, seed int64) [][]float64 {
+	rng := rand.New(rand.NewSource(seed))
+	result := make([][]float64, height)
+	for i := range result {
+		result[i] = make([]float64, width)
+	}
+
+	for fy := 0; fy < height/2; fy++ {
+		for fx := 0; fx < width/2; fx++ {
+			freq := math.Sqrt(float64(fx*fx + fy*fy))
+			if freq == 0 {
+				freq = 1
+			}
+			amplitude := 1.0 / math.Pow(freq, beta/2.0)
+			phase := rng.Float64() * 2 * math.Pi
+
+			for y := 0; y < height; y++ {
+				for x := 0; x < width; x++ {
+					angle := 2*math.Pi*(float64(fx*x)/float64(width)+float64(fy*y)/float64(height)) + phase
+					result[y][x] += amplitude * math.Cos(angle)
+				}
+			}
+		}
+	}
+
+	normalizeToUnitRange(result)
+	return result
+}
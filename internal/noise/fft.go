@@ -0,0 +1,86 @@
+package noise
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// fft performs an in-place iterative radix-2 Cooley-Tukey FFT.
+// len(data) must be a power of two. The inverse transform is scaled by 1/n
+func fft(data []complex128, inverse bool) {
+	n := len(data)
+	if n <= 1 {
+		return
+	}
+
+	// Bit-reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+
+	// Butterfly passes over doubling block sizes
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even := data[start+k]
+				odd := data[start+k+size/2] * w
+				data[start+k] = even + odd
+				data[start+k+size/2] = even - odd
+				w *= step
+			}
+		}
+	}
+
+	if inverse {
+		scale := complex(1/float64(n), 0)
+		for i := range data {
+			data[i] *= scale
+		}
+	}
+}
+
+// fft2D transforms a 2D array in place by running the FFT over every row and
+// then every column. Both dimensions must be powers of two
+func fft2D(data [][]complex128, inverse bool) {
+	for _, row := range data {
+		fft(row, inverse)
+	}
+
+	if len(data) == 0 {
+		return
+	}
+
+	column := make([]complex128, len(data))
+	for x := range data[0] {
+		for y := range data {
+			column[y] = data[y][x]
+		}
+		fft(column, inverse)
+		for y := range data {
+			data[y][x] = column[y]
+		}
+	}
+}
+
+// nextPowerOfTwo returns the smallest power of two >= n
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
@@ -0,0 +1,81 @@
+package noise
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestFFTRoundTrip(t *testing.T) {
+	data := make([]complex128, 16)
+	for i := range data {
+		data[i] = complex(math.Sin(float64(i)), float64(i%3))
+	}
+	original := make([]complex128, len(data))
+	copy(original, data)
+
+	fft(data, false)
+	fft(data, true)
+
+	for i := range data {
+		if cmplx.Abs(data[i]-original[i]) > 1e-9 {
+			t.Errorf("Round trip mismatch at %d: got %v, want %v", i, data[i], original[i])
+		}
+	}
+}
+
+func TestFFTMatchesDFT(t *testing.T) {
+	n := 8
+	data := make([]complex128, n)
+	for i := range data {
+		data[i] = complex(float64(i*i%5), float64(i)-3)
+	}
+
+	expected := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		for j := 0; j < n; j++ {
+			expected[k] += data[j] * cmplx.Rect(1, -2*math.Pi*float64(j*k)/float64(n))
+		}
+	}
+
+	fft(data, false)
+	for k := range data {
+		if cmplx.Abs(data[k]-expected[k]) > 1e-9 {
+			t.Errorf("Bin %d: got %v, want %v", k, data[k], expected[k])
+		}
+	}
+}
+
+func TestFFT2DHermitianGivesRealOutput(t *testing.T) {
+	// A single frequency paired with its conjugate is a real cosine wave
+	size := 8
+	data := make([][]complex128, size)
+	for i := range data {
+		data[i] = make([]complex128, size)
+	}
+	data[1][2] = cmplx.Rect(1, 0.7)
+	data[size-1][size-2] = cmplx.Rect(1, -0.7)
+
+	fft2D(data, true)
+
+	for y := range data {
+		for x := range data[y] {
+			if math.Abs(imag(data[y][x])) > 1e-12 {
+				t.Fatalf("Expected real output at (%d,%d), got %v", x, y, data[y][x])
+			}
+			expected := 2 * math.Cos(2*math.Pi*float64(2*x+y)/float64(size)+0.7) / float64(size*size)
+			if math.Abs(real(data[y][x])-expected) > 1e-12 {
+				t.Errorf("At (%d,%d): got %v, want %v", x, y, real(data[y][x]), expected)
+			}
+		}
+	}
+}
+
+func TestNextPowerOfTwo(t *testing.T) {
+	tests := map[int]int{0: 1, 1: 1, 2: 2, 3: 4, 24: 32, 64: 64, 65: 128}
+	for input, expected := range tests {
+		if got := nextPowerOfTwo(input); got != expected {
+			t.Errorf("nextPowerOfTwo(%d) = %d, expected %d", input, got, expected)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package noise
+
+// NoiseFunc generates a single layer of noise normalized to [-1, 1]
+type NoiseFunc func(width, height int, scale float64, seed int64) [][]float64
+
+// FractalNoise sums multiple octaves of a noise function (fractional Brownian
+// motion). Each octave raises the frequency by lacunarity and scales the
+// amplitude by persistence. The result is normalized to [-1, 1]
+func FractalNoise(width, height int, octaves int, persistence, lacunarity, scale float64, seed int64, fn NoiseFunc) [][]float64 {
+	result := make([][]float64, height)
+	for i := range result {
+		result[i] = make([]float64, width)
+	}
+
+	amplitude := 1.0
+	frequency := scale
+	maxValue := 0.0
+
+	for octave := 0; octave < octaves; octave++ {
+		layer := fn(width, height, frequency, octaveSeed(seed, octave))
+		for y := range result {
+			for x := range result[y] {
+				result[y][x] += layer[y][x] * amplitude
+			}
+		}
+
+		maxValue += amplitude
+		amplitude *= persistence
+		frequency *= lacunarity
+	}
+
+	normalizeOctaves(result, maxValue)
+
+	return result
+}
@@ -0,0 +1,44 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFractalNoise(t *testing.T) {
+	width, height := 40, 30
+	octaves := 4
+	persistence := 0.5
+	lacunarity := 2.0
+	scale := 0.05
+	seed := int64(42)
+
+	result := FractalNoise(width, height, octaves, persistence, lacunarity, scale, seed, PerlinNoise)
+
+	// Check dimensions
+	if len(result) != height || len(result[0]) != width {
+		t.Fatalf("Expected %dx%d result, got %dx%d", width, height, len(result[0]), len(result))
+	}
+
+	// Summed octaves divided by total amplitude stay within [-1, 1]
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			value := result[y][x]
+			if math.IsNaN(value) || value < -1.0 || value > 1.0 {
+				t.Errorf("Value out of range at (%d,%d): %f", x, y, value)
+			}
+		}
+	}
+
+	// Test determinism
+	result2 := FractalNoise(width, height, octaves, persistence, lacunarity, scale, seed, PerlinNoise)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if result[y][x] != result2[y][x] {
+				t.Errorf("Non-deterministic generation at (%d,%d): %f vs %f",
+					x, y, result[y][x], result2[y][x])
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package noise
+
+import "math"
+
+// PerlinNoise generates classic 2D gradient noise sampled at (x*scale, y*scale).
+// The permutation table is shuffled from the seed, so output is deterministic.
+// Values are normalized to [-1, 1]
+func PerlinNoise(width, height int, scale float64, seed int64) [][]float64 {
+	perm := NewSeededPerm(seed)
+
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		for x := range result[y] {
+			result[y][x] = perlin2D(perm, float64(x)*scale, float64(y)*scale)
+		}
+	}
+
+	normalizeToUnitRange(result)
+
+	return result
+}
+
+// TileablePerlinNoise generates Perlin noise that wraps seamlessly in both
+// directions, so column width-1 continues into column 0 and row height-1 into
+// row 0. The scale is rounded per axis to a whole number of lattice cells
+// across the output. Values are normalized to [-1, 1]
+func TileablePerlinNoise(width, height int, scale float64, seed int64) [][]float64 {
+	perm := NewSeededPerm(seed)
+	periodX := max(1, int(math.Round(float64(width)*scale)))
+	periodY := max(1, int(math.Round(float64(height)*scale)))
+
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		for x := range result[y] {
+			lx := float64(x) * float64(periodX) / float64(width)
+			ly := float64(y) * float64(periodY) / float64(height)
+			result[y][x] = perlin2DPeriodic(perm, lx, ly, periodX, periodY)
+		}
+	}
+
+	normalizeToUnitRange(result)
+
+	return result
+}
+
+// perlin2D evaluates improved Perlin noise at a point, returning roughly [-1, 1]
+func perlin2D(perm [512]int, x, y float64) float64 {
+	return perlin2DPeriodic(perm, x, y, 256, 256)
+}
+
+// perlin2DPeriodic evaluates Perlin noise with the gradient lattice wrapped to
+// periodX by periodY cells, so the noise repeats seamlessly with that period
+func perlin2DPeriodic(perm [512]int, x, y float64, periodX, periodY int) float64 {
+	// Lattice cell containing the point
+	x0 := math.Floor(x)
+	y0 := math.Floor(y)
+	xi := wrapIndex(int(x0), periodX) & 255
+	yi := wrapIndex(int(y0), periodY) & 255
+	xi1 := wrapIndex(int(x0)+1, periodX) & 255
+	yi1 := wrapIndex(int(y0)+1, periodY) & 255
+
+	// Position within the cell
+	xf := x - x0
+	yf := y - y0
+
+	// Hash the four cell corners
+	aa := perm[perm[xi]+yi]
+	ab := perm[perm[xi]+yi1]
+	ba := perm[perm[xi1]+yi]
+	bb := perm[perm[xi1]+yi1]
+
+	u := fade(xf)
+	v := fade(yf)
+
+	x1 := lerp(gradient(aa, xf, yf), gradient(ba, xf-1, yf), u)
+	x2 := lerp(gradient(ab, xf, yf-1), gradient(bb, xf-1, yf-1), u)
+
+	return lerp(x1, x2, v)
+}
+
+// wrapIndex maps a lattice index into [0, period)
+func wrapIndex(i, period int) int {
+	return ((i % period) + period) % period
+}
+
+// fade is Perlin's quintic smoothstep 6t^5 - 15t^4 + 10t^3
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// lerp linearly interpolates between a and b
+func lerp(a, b, t float64) float64 {
+	return a + t*(b-a)
+}
+
+// gradient returns the dot product of a hashed gradient direction with (x, y)
+func gradient(hash int, x, y float64) float64 {
+	switch hash & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+// normalizeToUnitRange linearly rescales values in place to span [-1, 1].
+// A constant field is set to 0
+func normalizeToUnitRange(data [][]float64) {
+	minVal, maxVal := findMinMax(data)
+	for y := range data {
+		for x := range data[y] {
+			if maxVal == minVal {
+				data[y][x] = 0
+			} else {
+				data[y][x] = 2*(data[y][x]-minVal)/(maxVal-minVal) - 1
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPerlinNoise(t *testing.T) {
+	width, height := 64, 48
+	scale := 0.08
+	seed := int64(42)
+
+	result := PerlinNoise(width, height, scale, seed)
+
+	// Check dimensions
+	if len(result) != height {
+		t.Errorf("Expected height %d, got %d", height, len(result))
+	}
+
+	for i, row := range result {
+		if len(row) != width {
+			t.Errorf("Row %d has wrong width: expected %d, got %d", i, width, len(row))
+		}
+	}
+
+	// Check normalization to [-1, 1]
+	minVal, maxVal := findMinMax(result)
+	tolerance := 1e-10
+
+	if math.Abs(minVal-(-1.0)) > tolerance {
+		t.Errorf("Expected minimum value -1.0, got %f", minVal)
+	}
+
+	if math.Abs(maxVal-1.0) > tolerance {
+		t.Errorf("Expected maximum value 1.0, got %f", maxVal)
+	}
+
+	// Gradient noise varies smoothly between neighboring samples
+	for y := 0; y < height; y++ {
+		for x := 1; x < width; x++ {
+			if delta := math.Abs(result[y][x] - result[y][x-1]); delta > 0.5 {
+				t.Errorf("Discontinuity at (%d,%d): delta %f", x, y, delta)
+			}
+		}
+	}
+
+	// Test determinism
+	result2 := PerlinNoise(width, height, scale, seed)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if result[y][x] != result2[y][x] {
+				t.Errorf("Non-deterministic generation at (%d,%d): %f vs %f",
+					x, y, result[y][x], result2[y][x])
+			}
+		}
+	}
+
+	// Different seeds should produce different results
+	result3 := PerlinNoise(width, height, scale, seed+1)
+
+	different := false
+	for y := 0; y < height && !different; y++ {
+		for x := 0; x < width; x++ {
+			if result[y][x] != result3[y][x] {
+				different = true
+				break
+			}
+		}
+	}
+
+	if !different {
+		t.Error("Different seeds should produce different noise")
+	}
+}
+
+func TestFade(t *testing.T) {
+	tests := []struct {
+		t, want float64
+	}{
+		{0.0, 0.0},
+		{0.5, 0.5},
+		{1.0, 1.0},
+	}
+
+	for _, tt := range tests {
+		if got := fade(tt.t); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("fade(%f) = %f, want %f", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestTileablePerlinNoise(t *testing.T) {
+	width, height := 60, 40
+	result := TileablePerlinNoise(width, height, 0.1, 42)
+
+	if len(result) != height || len(result[0]) != width {
+		t.Fatalf("Expected %dx%d, got %dx%d", width, height, len(result[0]), len(result))
+	}
+
+	seam, interior := seamDeltas(result)
+	if seam > interior {
+		t.Errorf("Seam delta %f exceeds interior neighbor delta %f", seam, interior)
+	}
+
+	// Wrapping the lattice at 256 cells matches the untiled noise
+	perm := NewSeededPerm(7)
+	for _, p := range [][2]float64{{0.3, 0.7}, {12.5, 200.25}, {255.5, 3.1}} {
+		if perlin2D(perm, p[0], p[1]) != perlin2DPeriodic(perm, p[0], p[1], 256, 256) {
+			t.Errorf("Periodic noise differs from standard noise at %v", p)
+		}
+	}
+}
+
+func BenchmarkPerlinNoise(b *testing.B) {
+	width, height := 100, 100
+	scale := 0.05
+	seed := int64(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		PerlinNoise(width, height, scale, seed)
+	}
+}
@@ -0,0 +1,38 @@
+package noise
+
+import "math/rand"
+
+// NewSeededPerm builds the doubled 256-entry permutation table used by the
+// gradient noises, shuffled by the seed. The second half repeats the first so
+// lookups like perm[perm[x]+y] never need wrapping
+func NewSeededPerm(seed int64) [512]int {
+	rng := rand.New(rand.NewSource(seed))
+
+	var perm [512]int
+	p := rng.Perm(256)
+	for i := 0; i < 512; i++ {
+		perm[i] = p[i&255]
+	}
+	return perm
+}
+
+// hash2 returns a well-mixed 64-bit hash of a lattice point under a seed.
+// It is order sensitive, so (x, y) and (y, x) hash differently
+func hash2(x, y int, seed int64) uint64 {
+	h := splitmix64(uint64(seed))
+	h = splitmix64(h ^ uint64(x))
+	return splitmix64(h ^ uint64(y))
+}
+
+// hashUnit maps a 64-bit hash to a float64 in [0, 1) using its top 53 bits
+func hashUnit(h uint64) float64 {
+	return float64(h>>11) / (1 << 53)
+}
+
+// splitmix64 is the SplitMix64 finalizer, a fast well-mixed 64-bit hash
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
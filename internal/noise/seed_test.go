@@ -0,0 +1,70 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewSeededPerm(t *testing.T) {
+	a := NewSeededPerm(42)
+	if a != NewSeededPerm(42) {
+		t.Error("Expected identical permutations for the same seed")
+	}
+	if a == NewSeededPerm(43) {
+		t.Error("Expected different permutations for different seeds")
+	}
+
+	// The first half is a permutation of 0-255 and the second half repeats it
+	seen := make(map[int]bool)
+	for i := 0; i < 256; i++ {
+		if a[i] < 0 || a[i] > 255 || seen[a[i]] {
+			t.Fatalf("Entry %d (%d) is out of range or repeated", i, a[i])
+		}
+		seen[a[i]] = true
+		if a[i+256] != a[i] {
+			t.Errorf("Entry %d is not doubled: %d vs %d", i, a[i], a[i+256])
+		}
+	}
+}
+
+func TestHash2(t *testing.T) {
+	if hash2(3, -7, 42) != hash2(3, -7, 42) {
+		t.Error("Expected identical hashes for the same input")
+	}
+	if hash2(3, -7, 42) == hash2(3, -7, 43) {
+		t.Error("Expected different hashes for different seeds")
+	}
+	if hash2(3, 7, 42) == hash2(7, 3, 42) {
+		t.Error("Expected swapped coordinates to hash differently")
+	}
+
+	// Neighboring lattice points spread evenly over the low bits
+	buckets := make([]int, 16)
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			buckets[hash2(x, y, 1)&15]++
+		}
+	}
+	for i, count := range buckets {
+		if count < 200 || count > 320 {
+			t.Errorf("Bucket %d has %d of 4096 hashes, expected about 256", i, count)
+		}
+	}
+}
+
+func TestHashUnit(t *testing.T) {
+	if hashUnit(0) != 0 {
+		t.Errorf("Expected zero hash to map to 0, got %f", hashUnit(0))
+	}
+	if v := hashUnit(math.MaxUint64); v >= 1 {
+		t.Errorf("Expected largest hash to map below 1, got %f", v)
+	}
+
+	sum := 0.0
+	for i := 0; i < 4096; i++ {
+		sum += hashUnit(hash2(i, 0, 7))
+	}
+	if mean := sum / 4096; math.Abs(mean-0.5) > 0.02 {
+		t.Errorf("Expected hashed values to average about 0.5, got %f", mean)
+	}
+}
@@ -0,0 +1,87 @@
+package noise
+
+import (
+	"math"
+)
+
+// Skewing factors for 2D simplex noise
+var (
+	simplexF2 = 0.5 * (math.Sqrt(3.0) - 1.0)
+	simplexG2 = (3.0 - math.Sqrt(3.0)) / 6.0
+)
+
+// simplexGradients are the 12 gradient directions used by 2D simplex noise
+var simplexGradients = [12][2]float64{
+	{1, 1}, {-1, 1}, {1, -1}, {-1, -1},
+	{1, 0}, {-1, 0}, {1, 0}, {-1, 0},
+	{0, 1}, {0, -1}, {0, 1}, {0, -1},
+}
+
+// SimplexNoise generates 2D simplex noise sampled at (x*scale, y*scale).
+// Simplex noise uses a triangular lattice, so it has fewer axis-aligned
+// artifacts than Perlin or Diamond-Square. Output is deterministic per seed
+// and normalized to [-1, 1]
+func SimplexNoise(width, height int, scale float64, seed int64) [][]float64 {
+	perm := NewSeededPerm(seed)
+
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		for x := range result[y] {
+			result[y][x] = simplex2D(perm, float64(x)*scale, float64(y)*scale)
+		}
+	}
+
+	normalizeToUnitRange(result)
+
+	return result
+}
+
+// simplex2D evaluates 2D simplex noise at a point, returning roughly [-1, 1]
+func simplex2D(perm [512]int, x, y float64) float64 {
+	// Skew input space to find which simplex cell we're in
+	s := (x + y) * simplexF2
+	i := math.Floor(x + s)
+	j := math.Floor(y + s)
+
+	// Unskew the cell origin back to (x, y) space
+	t := (i + j) * simplexG2
+	x0 := x - (i - t)
+	y0 := y - (j - t)
+
+	// Determine which of the two triangles contains the point
+	i1, j1 := 0, 1
+	if x0 > y0 {
+		i1, j1 = 1, 0
+	}
+
+	// Offsets for the middle and last corners
+	x1 := x0 - float64(i1) + simplexG2
+	y1 := y0 - float64(j1) + simplexG2
+	x2 := x0 - 1.0 + 2.0*simplexG2
+	y2 := y0 - 1.0 + 2.0*simplexG2
+
+	// Hash the three corners
+	ii := int(i) & 255
+	jj := int(j) & 255
+	gi0 := perm[ii+perm[jj]] % 12
+	gi1 := perm[ii+i1+perm[jj+j1]] % 12
+	gi2 := perm[ii+1+perm[jj+1]] % 12
+
+	// Sum the contributions from each corner
+	n := simplexCorner(gi0, x0, y0) + simplexCorner(gi1, x1, y1) + simplexCorner(gi2, x2, y2)
+
+	// Scale to roughly [-1, 1]
+	return 70.0 * n
+}
+
+// simplexCorner computes one corner's contribution with radial falloff
+func simplexCorner(gi int, x, y float64) float64 {
+	t := 0.5 - x*x - y*y
+	if t < 0 {
+		return 0
+	}
+	t *= t
+	g := simplexGradients[gi]
+	return t * t * (g[0]*x + g[1]*y)
+}
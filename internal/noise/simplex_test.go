@@ -0,0 +1,83 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimplexNoise(t *testing.T) {
+	width, height := 64, 64
+	scale := 0.08
+	seed := int64(42)
+
+	result := SimplexNoise(width, height, scale, seed)
+
+	// Check dimensions
+	if len(result) != height || len(result[0]) != width {
+		t.Fatalf("Expected %dx%d result, got %dx%d", width, height, len(result[0]), len(result))
+	}
+
+	// Check normalization to [-1, 1]
+	minVal, maxVal := findMinMax(result)
+	tolerance := 1e-10
+
+	if math.Abs(minVal-(-1.0)) > tolerance || math.Abs(maxVal-1.0) > tolerance {
+		t.Errorf("Expected range [-1, 1], got [%f, %f]", minVal, maxVal)
+	}
+
+	// Test determinism
+	result2 := SimplexNoise(width, height, scale, seed)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if result[y][x] != result2[y][x] {
+				t.Errorf("Non-deterministic generation at (%d,%d): %f vs %f",
+					x, y, result[y][x], result2[y][x])
+			}
+		}
+	}
+
+	// Different seeds should produce different results
+	result3 := SimplexNoise(width, height, scale, seed+1)
+	if result[height/2][width/2] == result3[height/2][width/2] &&
+		result[0][0] == result3[0][0] && result[height-1][width-1] == result3[height-1][width-1] {
+		t.Error("Different seeds should produce different noise")
+	}
+}
+
+func TestSimplexNoiseIsotropy(t *testing.T) {
+	width, height := 128, 128
+	scale := 0.1
+
+	// Average over several seeds the variance of differences between
+	// horizontal and vertical neighbors; banding would make one dominate
+	horizontal, vertical := 0.0, 0.0
+	for seed := int64(0); seed < 5; seed++ {
+		result := SimplexNoise(width, height, scale, seed)
+
+		for y := 0; y < height-1; y++ {
+			for x := 0; x < width-1; x++ {
+				dx := result[y][x+1] - result[y][x]
+				dy := result[y+1][x] - result[y][x]
+				horizontal += dx * dx
+				vertical += dy * dy
+			}
+		}
+	}
+
+	ratio := horizontal / vertical
+	if ratio < 0.8 || ratio > 1.25 {
+		t.Errorf("Row/column variance ratio %.3f suggests axis-aligned banding", ratio)
+	}
+}
+
+func BenchmarkSimplexNoise(b *testing.B) {
+	width, height := 100, 100
+	scale := 0.05
+	seed := int64(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SimplexNoise(width, height, scale, seed)
+	}
+}
@@ -0,0 +1,40 @@
+package noise
+
+// MakeTileable generates a field with generate at a larger size and cross-fades
+// the overhang back over the opposite edges, so the width x height result wraps
+// seamlessly in both directions. It works with any noise source, at the cost of
+// slightly reduced contrast within the blend bands
+func MakeTileable(width, height int, generate func(width, height int) [][]float64) [][]float64 {
+	blendX := max(1, width/4)
+	blendY := max(1, height/4)
+	field := generate(width+blendX, height+blendY)
+
+	// Blend horizontally: column x < blendX fades from the overhang column
+	// x+width (continuous with column width-1) into column x itself
+	rows := make([][]float64, height+blendY)
+	for y := range rows {
+		rows[y] = make([]float64, width)
+		for x := 0; x < width; x++ {
+			rows[y][x] = field[y][x]
+			if x < blendX {
+				t := float64(x) / float64(blendX)
+				rows[y][x] = lerp(field[y][x+width], field[y][x], t)
+			}
+		}
+	}
+
+	// Blend vertically in the same way
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		for x := range result[y] {
+			result[y][x] = rows[y][x]
+			if y < blendY {
+				t := float64(y) / float64(blendY)
+				result[y][x] = lerp(rows[y+height][x], rows[y][x], t)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+// seamDeltas returns the largest difference across the wrap seams and the
+// largest difference between interior neighbors
+func seamDeltas(data [][]float64) (seam, interior float64) {
+	height := len(data)
+	width := len(data[0])
+
+	for y := 0; y < height; y++ {
+		seam = math.Max(seam, math.Abs(data[y][width-1]-data[y][0]))
+		for x := 0; x+1 < width; x++ {
+			interior = math.Max(interior, math.Abs(data[y][x+1]-data[y][x]))
+		}
+	}
+	for x := 0; x < width; x++ {
+		seam = math.Max(seam, math.Abs(data[height-1][x]-data[0][x]))
+		for y := 0; y+1 < height; y++ {
+			interior = math.Max(interior, math.Abs(data[y+1][x]-data[y][x]))
+		}
+	}
+	return seam, interior
+}
+
+func TestMakeTileable(t *testing.T) {
+	width, height := 64, 48
+
+	result := MakeTileable(width, height, func(w, h int) [][]float64 {
+		return SimplexNoise(w, h, 0.05, 42)
+	})
+
+	if len(result) != height || len(result[0]) != width {
+		t.Fatalf("Expected %dx%d, got %dx%d", width, height, len(result[0]), len(result))
+	}
+
+	seam, interior := seamDeltas(result)
+	if seam > interior {
+		t.Errorf("Seam delta %f exceeds interior neighbor delta %f", seam, interior)
+	}
+
+	// Outside the blend bands the source field is untouched
+	source := SimplexNoise(width+width/4, height+height/4, 0.05, 42)
+	if result[height-1][width-1] != source[height-1][width-1] {
+		t.Error("Expected values outside the blend bands to be unchanged")
+	}
+}
+
+func TestMakeTileableTinyField(t *testing.T) {
+	result := MakeTileable(2, 1, func(w, h int) [][]float64 {
+		return PerlinNoise(w, h, 0.5, 1)
+	})
+	if len(result) != 1 || len(result[0]) != 2 {
+		t.Errorf("Expected 2x1 result, got %dx%d", len(result[0]), len(result))
+	}
+}
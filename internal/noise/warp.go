@@ -0,0 +1,73 @@
+package noise
+
+import (
+	"math"
+)
+
+// warpScale is the frequency of the noise fields used to displace samples
+const warpScale = 0.05
+
+// DomainWarp resamples a heightmap after offsetting each sample position by a
+// pair of secondary noise fields, breaking up the regular look of fractal
+// noise. warpStrength is the maximum displacement in cells; 0 returns an
+// unchanged copy. The output has the same dimensions and is deterministic
+func DomainWarp(heightmap [][]float64, warpStrength float64, seed int64) [][]float64 {
+	height := len(heightmap)
+	if height == 0 {
+		return [][]float64{}
+	}
+	width := len(heightmap[0])
+
+	result := make([][]float64, height)
+	for y := range result {
+		result[y] = make([]float64, width)
+		copy(result[y], heightmap[y])
+	}
+
+	if warpStrength == 0 {
+		return result
+	}
+
+	// Independent displacement fields for each axis
+	warpX := PerlinNoise(width, height, warpScale, seed)
+	warpY := PerlinNoise(width, height, warpScale, seed+1)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			sx := float64(x) + warpX[y][x]*warpStrength
+			sy := float64(y) + warpY[y][x]*warpStrength
+			result[y][x] = sampleBilinear(heightmap, sx, sy)
+		}
+	}
+
+	return result
+}
+
+// sampleBilinear interpolates a 2D field at a fractional position, clamping
+// positions outside the field to its edges
+func sampleBilinear(data [][]float64, x, y float64) float64 {
+	height := len(data)
+	width := len(data[0])
+
+	x = math.Max(0, math.Min(float64(width-1), x))
+	y = math.Max(0, math.Min(float64(height-1), y))
+
+	x0 := int(math.Floor(x))
+	y0 := int(math.Floor(y))
+	x1 := x0 + 1
+	y1 := y0 + 1
+	if x1 >= width {
+		x1 = width - 1
+	}
+	if y1 >= height {
+		y1 = height - 1
+	}
+
+	tx := x - float64(x0)
+	ty := y - float64(y0)
+
+	top := lerp(data[y0][x0], data[y0][x1], tx)
+	bottom := lerp(data[y1][x0], data[y1][x1], tx)
+
+	return lerp(top, bottom, ty)
+}
@@ -0,0 +1,86 @@
+package noise
+
+import (
+	"testing"
+)
+
+func TestDomainWarp(t *testing.T) {
+	width, height := 64, 48
+	heightmap := PerlinNoise(width, height, 0.1, 42)
+
+	// Zero strength returns the input unchanged
+	unwarped := DomainWarp(heightmap, 0, 7)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if unwarped[y][x] != heightmap[y][x] {
+				t.Fatalf("Zero-strength warp changed (%d,%d): %f vs %f",
+					x, y, heightmap[y][x], unwarped[y][x])
+			}
+		}
+	}
+
+	warped := DomainWarp(heightmap, 8.0, 7)
+
+	// Dimensions are preserved
+	if len(warped) != height || len(warped[0]) != width {
+		t.Fatalf("Expected %dx%d result, got %dx%d", width, height, len(warped[0]), len(warped))
+	}
+
+	// A significant fraction of cells change
+	changed := 0
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if warped[y][x] != heightmap[y][x] {
+				changed++
+			}
+		}
+	}
+	if fraction := float64(changed) / float64(width*height); fraction < 0.5 {
+		t.Errorf("Expected most cells to change, only %.1f%% did", fraction*100)
+	}
+
+	// Deterministic per seed
+	again := DomainWarp(heightmap, 8.0, 7)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if again[y][x] != warped[y][x] {
+				t.Fatalf("Non-deterministic warp at (%d,%d)", x, y)
+			}
+		}
+	}
+
+	// The input heightmap is not modified
+	original := PerlinNoise(width, height, 0.1, 42)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if heightmap[y][x] != original[y][x] {
+				t.Fatalf("DomainWarp modified its input at (%d,%d)", x, y)
+			}
+		}
+	}
+}
+
+func TestSampleBilinear(t *testing.T) {
+	data := [][]float64{
+		{0, 10},
+		{20, 30},
+	}
+
+	tests := []struct {
+		x, y, want float64
+	}{
+		{0, 0, 0},
+		{1, 1, 30},
+		{0.5, 0, 5},
+		{0, 0.5, 10},
+		{0.5, 0.5, 15},
+		{-3, -3, 0}, // clamped to the edge
+		{5, 5, 30},
+	}
+
+	for _, tt := range tests {
+		if got := sampleBilinear(data, tt.x, tt.y); got != tt.want {
+			t.Errorf("sampleBilinear(%.1f, %.1f) = %f, want %f", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package noise
+
+import (
+	"math"
+)
+
+// WorleyNoise generates cellular noise where each sample is the distance to the
+// nearest of numPoints random feature points (F1). The result is normalized to
+// [-1, 1], so cell centers are low and cell borders are high
+func WorleyNoise(width, height int, numPoints int, seed int64) [][]float64 {
+	f1, _ := worleyDistances(width, height, worleyPoints(width, height, numPoints, seed))
+	normalizeToUnitRange(f1)
+	return f1
+}
+
+// WorleyRidges generates cellular noise from the difference between the second
+// and first nearest feature point distances (F2 - F1). This is near zero along
+// cell borders, forming ridge networks. Normalized to [-1, 1]
+func WorleyRidges(width, height int, numPoints int, seed int64) [][]float64 {
+	f1, f2 := worleyDistances(width, height, worleyPoints(width, height, numPoints, seed))
+	for y := range f1 {
+		for x := range f1[y] {
+			f1[y][x] = f2[y][x] - f1[y][x]
+		}
+	}
+	normalizeToUnitRange(f1)
+	return f1
+}
+
+// worleyPoints scatters feature points uniformly over the field. Each point is
+// hashed from its index, so it doesn't depend on how many points come before it
+func worleyPoints(width, height int, numPoints int, seed int64) [][2]float64 {
+	points := make([][2]float64, numPoints)
+	for i := range points {
+		points[i] = [2]float64{
+			hashUnit(hash2(i, 0, seed)) * float64(width),
+			hashUnit(hash2(i, 1, seed)) * float64(height),
+		}
+	}
+	return points
+}
+
+// worleyDistances returns the distances to the nearest (F1) and second
+// nearest (F2) feature point for every sample. With fewer than two points,
+// F2 equals F1
+func worleyDistances(width, height int, points [][2]float64) ([][]float64, [][]float64) {
+	f1 := make([][]float64, height)
+	f2 := make([][]float64, height)
+
+	for y := 0; y < height; y++ {
+		f1[y] = make([]float64, width)
+		f2[y] = make([]float64, width)
+
+		for x := 0; x < width; x++ {
+			nearest, second := math.Inf(1), math.Inf(1)
+			for _, p := range points {
+				d := math.Hypot(float64(x)-p[0], float64(y)-p[1])
+				if d < nearest {
+					nearest, second = d, nearest
+				} else if d < second {
+					second = d
+				}
+			}
+
+			if math.IsInf(nearest, 1) {
+				nearest = 0
+			}
+			if math.IsInf(second, 1) {
+				second = nearest
+			}
+
+			f1[y][x] = nearest
+			f2[y][x] = second
+		}
+	}
+
+	return f1, f2
+}
@@ -0,0 +1,86 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWorleyNoise(t *testing.T) {
+	width, height := 60, 40
+	numPoints := 12
+	seed := int64(42)
+
+	result := WorleyNoise(width, height, numPoints, seed)
+
+	// Check dimensions
+	if len(result) != height || len(result[0]) != width {
+		t.Fatalf("Expected %dx%d result, got %dx%d", width, height, len(result[0]), len(result))
+	}
+
+	// Check normalization to [-1, 1]
+	minVal, maxVal := findMinMax(result)
+	tolerance := 1e-10
+
+	if math.Abs(minVal-(-1.0)) > tolerance || math.Abs(maxVal-1.0) > tolerance {
+		t.Errorf("Expected range [-1, 1], got [%f, %f]", minVal, maxVal)
+	}
+
+	// Test determinism
+	result2 := WorleyNoise(width, height, numPoints, seed)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if result[y][x] != result2[y][x] {
+				t.Errorf("Non-deterministic generation at (%d,%d): %f vs %f",
+					x, y, result[y][x], result2[y][x])
+			}
+		}
+	}
+}
+
+func TestWorleyCellSize(t *testing.T) {
+	width, height := 80, 60
+	seed := int64(7)
+
+	// More feature points means smaller cells, so samples are closer to
+	// their nearest point on average
+	previous := math.Inf(1)
+	for _, numPoints := range []int{4, 16, 64} {
+		f1, _ := worleyDistances(width, height, worleyPoints(width, height, numPoints, seed))
+
+		sum := 0.0
+		for y := range f1 {
+			for x := range f1[y] {
+				sum += f1[y][x]
+			}
+		}
+		mean := sum / float64(width*height)
+
+		if mean >= previous {
+			t.Errorf("%d points: mean cell distance %.2f did not decrease from %.2f",
+				numPoints, mean, previous)
+		}
+		previous = mean
+	}
+}
+
+func TestWorleyRidges(t *testing.T) {
+	width, height := 60, 40
+
+	result := WorleyRidges(width, height, 12, 42)
+
+	minVal, maxVal := findMinMax(result)
+	if minVal < -1.0 || maxVal > 1.0 {
+		t.Errorf("Expected range [-1, 1], got [%f, %f]", minVal, maxVal)
+	}
+
+	// F2 - F1 is never negative before normalization
+	f1, f2 := worleyDistances(width, height, worleyPoints(width, height, 12, 42))
+	for y := range f1 {
+		for x := range f1[y] {
+			if f2[y][x] < f1[y][x] {
+				t.Fatalf("F2 < F1 at (%d,%d): %f < %f", x, y, f2[y][x], f1[y][x])
+			}
+		}
+	}
+}
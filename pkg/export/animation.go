@@ -0,0 +1,96 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"math"
+	"os"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// Land/water frame colors
+var (
+	frameBackground = color.RGBA{0, 0, 0, 255}
+	frameWater      = color.RGBA{30, 80, 160, 255}
+	frameLand       = color.RGBA{70, 140, 60, 255}
+)
+
+// RenderLandWater draws each hex as land or water relative to seaLevel. The
+// image is sized to the grid's pixel bounds, and pixels outside every hex
+// are left black
+func RenderLandWater(tiles []*terrain.HexTile, grid *hex.Grid, hexSize, seaLevel float64) *image.RGBA {
+	tileMap := make(map[hex.AxialCoord]*terrain.HexTile, len(tiles))
+	for _, tile := range tiles {
+		tileMap[tile.Coordinates] = tile
+	}
+
+	return renderHexes(grid, hexSize, func(coord hex.AxialCoord) (color.RGBA, bool) {
+		tile, ok := tileMap[coord]
+		switch {
+		case !ok:
+			return color.RGBA{}, false
+		case tile.Elevation > seaLevel:
+			return frameLand, true
+		default:
+			return frameWater, true
+		}
+	})
+}
+
+// renderHexes fills an image sized to the grid's pixel bounds, coloring each
+// pixel by the hex under its center. Pixels where colorOf reports no color are
+// left as frameBackground
+func renderHexes(grid *hex.Grid, hexSize float64, colorOf func(hex.AxialCoord) (color.RGBA, bool)) *image.RGBA {
+	minX, minY, maxX, maxY := grid.PixelBounds(hexSize)
+	width := int(math.Ceil(maxX - minX))
+	height := int(math.Ceil(maxY - minY))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// Sample at the pixel center
+			coord := grid.PixelToAxial(float64(x)+0.5+minX, float64(y)+0.5+minY, hexSize)
+			if c, ok := colorOf(coord); ok {
+				img.SetRGBA(x, y, c)
+			} else {
+				img.SetRGBA(x, y, frameBackground)
+			}
+		}
+	}
+
+	return img
+}
+
+// ExportAnimatedGIF writes frames as a looping GIF, showing each for delayMs
+// milliseconds. Frames are mapped to the web-safe palette; GIF delays are
+// stored in hundredths of a second
+func ExportAnimatedGIF(frames []*image.RGBA, filename string, delayMs int) error {
+	if len(frames) == 0 {
+		return &terrain.TerrainError{Message: "no frames to animate"}
+	}
+
+	anim := &gif.GIF{}
+	for _, frame := range frames {
+		bounds := frame.Bounds()
+		paletted := image.NewPaletted(bounds, palette.WebSafe)
+		draw.Draw(paletted, bounds, frame, bounds.Min, draw.Src)
+
+		anim.Image = append(anim.Image, paletted)
+		anim.Delay = append(anim.Delay, delayMs/10)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := gif.EncodeAll(file, anim); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
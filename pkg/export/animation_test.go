@@ -0,0 +1,84 @@
+package export
+
+import (
+	"image"
+	"image/gif"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestRenderLandWater(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 4, Height: 3, Topology: hex.TopologyRegion})
+	var tiles []*terrain.HexTile
+	for _, coord := range grid.AllCoords() {
+		col, _ := coord.ToOffset()
+		tiles = append(tiles, &terrain.HexTile{Coordinates: coord, Elevation: float64(col*100 - 150)})
+	}
+
+	const hexSize = 10.0
+	img := RenderLandWater(tiles, grid, hexSize, 0)
+	minX, minY, _, _ := grid.PixelBounds(hexSize)
+
+	// Hex centers take their tile's land/water color
+	for _, tile := range tiles {
+		cx, cy := grid.ToPixel(tile.Coordinates, hexSize)
+		got := img.RGBAAt(int(cx-minX), int(cy-minY))
+		expected := frameWater
+		if tile.Elevation > 0 {
+			expected = frameLand
+		}
+		if got != expected {
+			t.Errorf("Hex %v center is %v, expected %v", tile.Coordinates, got, expected)
+		}
+	}
+
+	// Flat-top grids leave the top-left corner uncovered
+	if got := img.RGBAAt(0, 0); got != frameBackground {
+		t.Errorf("Uncovered corner is %v, expected background", got)
+	}
+}
+
+func TestExportAnimatedGIF(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 10, Height: 8, Topology: hex.TopologyRegion})
+	tiles, err := terrain.GenerateTerrain(grid, terrain.DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	var frames []*image.RGBA
+	for _, seaLevel := range []float64{-100, 0, 100, 200, 300} {
+		frames = append(frames, RenderLandWater(tiles, grid, 6, seaLevel))
+	}
+
+	path := filepath.Join(t.TempDir(), "flood.gif")
+	if err := ExportAnimatedGIF(frames, path, 200); err != nil {
+		t.Fatalf("ExportAnimatedGIF() failed: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	defer file.Close()
+
+	anim, err := gif.DecodeAll(file)
+	if err != nil {
+		t.Fatalf("Output is not a valid GIF: %v", err)
+	}
+	if len(anim.Image) != len(frames) {
+		t.Errorf("Expected %d frames, got %d", len(frames), len(anim.Image))
+	}
+	for i, delay := range anim.Delay {
+		if delay != 20 {
+			t.Errorf("Frame %d delay = %d, expected 20", i, delay)
+		}
+	}
+
+	if err := ExportAnimatedGIF(nil, path, 100); err == nil {
+		t.Error("Expected error for no frames")
+	}
+}
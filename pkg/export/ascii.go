@@ -0,0 +1,69 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// Elevation bands used by RenderASCII
+const (
+	asciiShallowDepth = -200.0 // Water shallower than this is drawn as shallow
+	asciiHillHeight   = 500.0  // Land at or above this is drawn as hills
+	asciiPeakHeight   = 2000.0 // Land at or above this is drawn as mountains
+)
+
+// RenderASCII prints terrain as text, one character per hex:
+// '~' deep water, '.' shallow water, ',' plains, '^' hills, 'A' mountains.
+// Each hex row takes two text lines so the even-q column stagger is visible:
+// even columns sit half a row lower than odd ones
+func RenderASCII(tiles []*terrain.HexTile, grid *hex.Grid, w io.Writer) error {
+	// Size the text to the grid's offset bounding box so shaped grids fit
+	minCol, minRow, maxCol, maxRow := grid.Bounds()
+	width, height := max(0, maxCol-minCol+1), max(0, maxRow-minRow+1)
+	lines := make([][]byte, height*2+1)
+	for i := range lines {
+		lines[i] = []byte(strings.Repeat(" ", width*2))
+	}
+
+	for _, tile := range tiles {
+		col, row := grid.WrapCoord(tile.Coordinates).ToOffset()
+		x, y := col-minCol, row-minRow
+		if x < 0 || x >= width || y < 0 || y >= height {
+			continue
+		}
+
+		line := y * 2
+		if col&1 == 0 {
+			line++
+		}
+		lines[line][x*2] = asciiSymbol(tile)
+	}
+
+	out := bufio.NewWriter(w)
+	for _, line := range lines {
+		if _, err := out.WriteString(strings.TrimRight(string(line), " ") + "\n"); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// asciiSymbol returns the character for a tile's elevation band
+func asciiSymbol(tile *terrain.HexTile) byte {
+	switch {
+	case !tile.IsLand && tile.Elevation < asciiShallowDepth:
+		return '~'
+	case !tile.IsLand:
+		return '.'
+	case tile.Elevation >= asciiPeakHeight:
+		return 'A'
+	case tile.Elevation >= asciiHillHeight:
+		return '^'
+	default:
+		return ','
+	}
+}
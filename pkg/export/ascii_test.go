@@ -0,0 +1,88 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestRenderASCII(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 5, Height: 3, Topology: hex.TopologyRegion})
+
+	// One elevation band per column, from deep ocean to mountains
+	bands := []float64{-3000, -50, 100, 1000, 4000}
+	var tiles []*terrain.HexTile
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 5; col++ {
+			elevation := bands[col]
+			tiles = append(tiles, &terrain.HexTile{
+				Coordinates: hex.OffsetToAxial(col, row),
+				Elevation:   elevation,
+				IsLand:      elevation > 0,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := RenderASCII(tiles, grid, &buf); err != nil {
+		t.Fatalf("RenderASCII() failed: %v", err)
+	}
+
+	expected := "" +
+		"  .   ^\n" +
+		"~   ,   A\n" +
+		"  .   ^\n" +
+		"~   ,   A\n" +
+		"  .   ^\n" +
+		"~   ,   A\n" +
+		"\n"
+	if buf.String() != expected {
+		t.Errorf("RenderASCII() output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestRenderASCIIDeterministic(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 16, Height: 8, Topology: hex.TopologyWorld})
+	tiles, err := terrain.GenerateTerrain(grid, terrain.DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	var first, second bytes.Buffer
+	if err := RenderASCII(tiles, grid, &first); err != nil {
+		t.Fatalf("RenderASCII() failed: %v", err)
+	}
+	if err := RenderASCII(tiles, grid, &second); err != nil {
+		t.Fatalf("RenderASCII() failed: %v", err)
+	}
+
+	if first.String() != second.String() {
+		t.Error("Expected identical output for identical terrain")
+	}
+	if bytes.Count(first.Bytes(), []byte("\n")) != 17 {
+		t.Errorf("Expected 17 lines, got %d", bytes.Count(first.Bytes(), []byte("\n")))
+	}
+}
+
+func TestRenderASCIIShapedGrids(t *testing.T) {
+	for _, shape := range []hex.Shape{hex.ShapeHexagon, hex.ShapeRhombus, hex.ShapeTriangle} {
+		grid := hex.NewGrid(hex.GridConfig{Width: 7, Height: 5, Shape: shape, Topology: hex.TopologyRegion})
+
+		var tiles []*terrain.HexTile
+		for _, coord := range grid.AllCoords() {
+			tiles = append(tiles, &terrain.HexTile{Coordinates: coord, Elevation: 100, IsLand: true})
+		}
+
+		var buf bytes.Buffer
+		if err := RenderASCII(tiles, grid, &buf); err != nil {
+			t.Fatalf("RenderASCII() failed: %v", err)
+		}
+
+		// Every hex of the shape is drawn exactly once
+		if drawn := bytes.Count(buf.Bytes(), []byte(",")); drawn != len(tiles) {
+			t.Errorf("Shape %d: drew %d hexes, expected %d:\n%s", shape, drawn, len(tiles), buf.String())
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Package export writes generated terrain to formats used by external tools
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// geoJSONFeatureCollection is the top-level GeoJSON document
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// geoJSONFeature is a single hex polygon with its terrain properties
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	Geometry   geoJSONPolygon  `json:"geometry"`
+	Properties geoJSONHexProps `json:"properties"`
+}
+
+// geoJSONPolygon holds one closed ring of planar coordinates
+type geoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// geoJSONHexProps are the per-hex attributes exported to GIS tools
+type geoJSONHexProps struct {
+	Q               int     `json:"q"`
+	R               int     `json:"r"`
+	Elevation       float64 `json:"elevation"`
+	IsLand          bool    `json:"is_land"`
+	DistanceToWater float64 `json:"distance_to_water"`
+}
+
+// ExportGeoJSON writes each tile as a GeoJSON Polygon feature built from its
+// six hex vertices in planar pixel space, using the grid's orientation.
+// The y axis is flipped so north is up, as GIS tools expect
+func ExportGeoJSON(tiles []*terrain.HexTile, grid *hex.Grid, hexSize float64, w io.Writer) error {
+	collection := geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]geoJSONFeature, 0, len(tiles)),
+	}
+
+	for _, tile := range tiles {
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONPolygon{
+				Type:        "Polygon",
+				Coordinates: [][][2]float64{hexRing(tile.Coordinates, grid, hexSize)},
+			},
+			Properties: geoJSONHexProps{
+				Q:               tile.Coordinates.Q,
+				R:               tile.Coordinates.R,
+				Elevation:       tile.Elevation,
+				IsLand:          tile.IsLand,
+				DistanceToWater: tile.DistanceToWater,
+			},
+		})
+	}
+
+	return json.NewEncoder(w).Encode(collection)
+}
+
+// hexRing returns the closed counter-clockwise vertex ring of a hex, with the
+// first vertex repeated at the end as GeoJSON requires
+func hexRing(coord hex.AxialCoord, grid *hex.Grid, hexSize float64) [][2]float64 {
+	cx, cy := grid.ToPixel(coord, hexSize)
+	orientation := grid.Config().Orientation
+
+	ring := make([][2]float64, 7)
+	for i := 0; i < 6; i++ {
+		dx, dy := hex.CornerOffset(orientation, hexSize, i)
+		ring[i] = [2]float64{cx + dx, -(cy + dy)}
+	}
+	ring[6] = ring[0]
+
+	return ring
+}
@@ -0,0 +1,82 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestExportGeoJSON(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 6, Height: 4, Topology: hex.TopologyRegion})
+	tiles, err := terrain.GenerateTerrain(grid, terrain.DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportGeoJSON(tiles, grid, 10, &buf); err != nil {
+		t.Fatalf("ExportGeoJSON() failed: %v", err)
+	}
+
+	var doc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			Geometry struct {
+				Type        string         `json:"type"`
+				Coordinates [][][2]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if doc.Type != "FeatureCollection" {
+		t.Errorf("Expected FeatureCollection, got %q", doc.Type)
+	}
+	if len(doc.Features) != len(tiles) {
+		t.Fatalf("Expected %d features, got %d", len(tiles), len(doc.Features))
+	}
+
+	for i, feature := range doc.Features {
+		if feature.Geometry.Type != "Polygon" {
+			t.Errorf("Feature %d: expected Polygon, got %q", i, feature.Geometry.Type)
+		}
+		ring := feature.Geometry.Coordinates[0]
+		if len(ring) != 7 || ring[0] != ring[6] {
+			t.Errorf("Feature %d: expected a closed 7-point ring, got %v", i, ring)
+		}
+		for _, key := range []string{"elevation", "is_land", "q", "r"} {
+			if _, ok := feature.Properties[key]; !ok {
+				t.Errorf("Feature %d: missing property %q", i, key)
+			}
+		}
+	}
+}
+
+func TestHexRingSharesEdges(t *testing.T) {
+	// Adjacent hexes share exactly two vertices in both orientations
+	for _, orientation := range []hex.Orientation{hex.FlatTop, hex.PointyTop} {
+		grid := hex.NewGrid(hex.GridConfig{Width: 4, Height: 4, Orientation: orientation})
+		a := hexRing(hex.NewAxialCoord(1, 1), grid, 10)
+		b := hexRing(hex.NewAxialCoord(2, 1), grid, 10)
+
+		shared := 0
+		for _, va := range a[:6] {
+			for _, vb := range b[:6] {
+				if math.Abs(va[0]-vb[0]) < 1e-9 && math.Abs(va[1]-vb[1]) < 1e-9 {
+					shared++
+				}
+			}
+		}
+		if shared != 2 {
+			t.Errorf("Orientation %d: expected 2 shared vertices, got %d", orientation, shared)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// ExportHeightmapPNG writes a heightmap as a 16-bit grayscale PNG, mapping the
+// lowest value to 0 and the highest to 65535. A flat heightmap is written as
+// all zeros. Rows must all have the same length
+func ExportHeightmapPNG(heightmap [][]float64, filename string) error {
+	if len(heightmap) == 0 || len(heightmap[0]) == 0 {
+		return &terrain.TerrainError{Message: "empty heightmap"}
+	}
+
+	width, height := len(heightmap[0]), len(heightmap)
+	minVal, maxVal := math.Inf(1), math.Inf(-1)
+	for _, row := range heightmap {
+		if len(row) != width {
+			return &terrain.TerrainError{Message: "heightmap rows have different lengths"}
+		}
+		for _, v := range row {
+			minVal = math.Min(minVal, v)
+			maxVal = math.Max(maxVal, v)
+		}
+	}
+
+	scale := 0.0
+	if maxVal > minVal {
+		scale = math.MaxUint16 / (maxVal - minVal)
+	}
+
+	img := image.NewGray16(image.Rect(0, 0, width, height))
+	for y, row := range heightmap {
+		for x, v := range row {
+			img.SetGray16(x, y, color.Gray16{Y: uint16(math.Round((v - minVal) * scale))})
+		}
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ImportHeightmapPNG reads a grayscale PNG into a heightmap normalized to
+// [0, 1], with black at 0 and white at 1. Color images are converted to
+// gray by luminance. 8-bit and 16-bit images are both supported
+func ImportHeightmapPNG(filename string) ([][]float64, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	heightmap := make([][]float64, bounds.Dy())
+	for y := range heightmap {
+		heightmap[y] = make([]float64, bounds.Dx())
+		for x := range heightmap[y] {
+			gray := color.Gray16Model.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.Gray16)
+			heightmap[y][x] = float64(gray.Y) / math.MaxUint16
+		}
+	}
+
+	return heightmap, nil
+}
@@ -0,0 +1,125 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportHeightmapPNG(t *testing.T) {
+	heightmap := [][]float64{
+		{-4000, -1000, 0, 250},
+		{500, 1000, 2000, 8000},
+		{-4000, 0, 0, 0},
+	}
+	filename := filepath.Join(t.TempDir(), "heightmap.png")
+
+	if err := ExportHeightmapPNG(heightmap, filename); err != nil {
+		t.Fatalf("ExportHeightmapPNG failed: %v", err)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("Failed to open PNG: %v", err)
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("Output is not a valid PNG: %v", err)
+	}
+	gray, ok := img.(*image.Gray16)
+	if !ok {
+		t.Fatalf("Expected a 16-bit grayscale image, got %T", img)
+	}
+	if bounds := gray.Bounds(); bounds.Dx() != 4 || bounds.Dy() != 3 {
+		t.Fatalf("Expected 4x3 pixels, got %dx%d", bounds.Dx(), bounds.Dy())
+	}
+
+	// The extremes span the full 16-bit range and ordering is preserved
+	if got := gray.Gray16At(0, 0); got != (color.Gray16{Y: 0}) {
+		t.Errorf("Expected the minimum at 0, got %d", got.Y)
+	}
+	if got := gray.Gray16At(3, 1); got != (color.Gray16{Y: 65535}) {
+		t.Errorf("Expected the maximum at 65535, got %d", got.Y)
+	}
+	if gray.Gray16At(1, 1).Y <= gray.Gray16At(0, 1).Y || gray.Gray16At(0, 1).Y <= gray.Gray16At(3, 0).Y {
+		t.Error("Expected pixel values to follow elevation order")
+	}
+}
+
+func TestExportHeightmapPNGErrors(t *testing.T) {
+	dir := t.TempDir()
+	if err := ExportHeightmapPNG(nil, filepath.Join(dir, "empty.png")); err == nil {
+		t.Error("Expected an error for an empty heightmap")
+	}
+	if err := ExportHeightmapPNG([][]float64{{1, 2}, {3}}, filepath.Join(dir, "ragged.png")); err == nil {
+		t.Error("Expected an error for ragged rows")
+	}
+}
+
+func TestImportHeightmapPNGRoundTrip(t *testing.T) {
+	heightmap := [][]float64{
+		{-4000, -1000, 0, 250, 3},
+		{500, 1000, 2000, 8000, 7.5},
+	}
+	filename := filepath.Join(t.TempDir(), "heightmap.png")
+	if err := ExportHeightmapPNG(heightmap, filename); err != nil {
+		t.Fatalf("ExportHeightmapPNG failed: %v", err)
+	}
+
+	imported, err := ImportHeightmapPNG(filename)
+	if err != nil {
+		t.Fatalf("ImportHeightmapPNG failed: %v", err)
+	}
+	if len(imported) != 2 || len(imported[0]) != 5 {
+		t.Fatalf("Expected 5x2 heightmap, got %dx%d", len(imported[0]), len(imported))
+	}
+
+	// Relative elevations survive to within one 16-bit step
+	const minVal, maxVal = -4000.0, 8000.0
+	for y := range heightmap {
+		for x := range heightmap[y] {
+			expected := (heightmap[y][x] - minVal) / (maxVal - minVal)
+			if math.Abs(imported[y][x]-expected) > 1.0/65535 {
+				t.Errorf("Cell (%d,%d) imported as %f, expected %f", x, y, imported[y][x], expected)
+			}
+		}
+	}
+	if imported[0][4] >= imported[1][4] {
+		t.Error("Expected nearby elevations 3m and 7.5m to stay ordered")
+	}
+}
+
+func TestImportHeightmapPNG8Bit(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 3, 1))
+	img.SetGray(0, 0, color.Gray{Y: 0})
+	img.SetGray(1, 0, color.Gray{Y: 128})
+	img.SetGray(2, 0, color.Gray{Y: 255})
+
+	filename := filepath.Join(t.TempDir(), "gray8.png")
+	file, err := os.Create(filename)
+	if err != nil {
+		t.Fatalf("Failed to create PNG: %v", err)
+	}
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("Failed to encode PNG: %v", err)
+	}
+	file.Close()
+
+	imported, err := ImportHeightmapPNG(filename)
+	if err != nil {
+		t.Fatalf("ImportHeightmapPNG failed: %v", err)
+	}
+	if imported[0][0] != 0 || imported[0][2] != 1 || math.Abs(imported[0][1]-128.0/255) > 1e-9 {
+		t.Errorf("Unexpected 8-bit import: %v", imported[0])
+	}
+
+	if _, err := ImportHeightmapPNG(filepath.Join(t.TempDir(), "missing.png")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
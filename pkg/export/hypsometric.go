@@ -0,0 +1,144 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// Hypsometric plot layout, in pixels
+const (
+	hypsometricWidth  = 640
+	hypsometricHeight = 400
+	hypsometricMargin = 40
+)
+
+// Hypsometric plot colors
+var (
+	plotBackground = color.RGBA{255, 255, 255, 255}
+	plotAxis       = color.RGBA{96, 96, 96, 255}
+	plotSeaLevel   = color.RGBA{192, 192, 192, 255}
+	plotEarth      = color.RGBA{40, 90, 200, 255}
+	plotTerrain    = color.RGBA{210, 50, 40, 255}
+)
+
+// ExportHypsometricCurve plots the terrain's hypsometric curve in red against
+// Earth's reference curve in blue and writes it as a PNG. The x axis is the
+// fraction of area lying above each elevation, from 0 at the left to 1 at the
+// right; the y axis spans the elevations of both curves, with a gray line at
+// sea level
+func ExportHypsometricCurve(tiles []*terrain.HexTile, filename string) error {
+	if len(tiles) == 0 {
+		return &terrain.TerrainError{Message: "no tiles to plot"}
+	}
+
+	// Highest first, so position i has i tiles above it
+	elevations := make([]float64, len(tiles))
+	for i, tile := range tiles {
+		elevations[i] = tile.Elevation
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(elevations)))
+
+	earth := terrain.EarthHypsometricReference()
+	fractions, earthElevations := earth.Percentiles, earth.Elevations
+
+	minElev := math.Min(elevations[len(elevations)-1], earthElevations[0])
+	maxElev := math.Max(elevations[0], earthElevations[len(earthElevations)-1])
+	if maxElev == minElev {
+		maxElev = minElev + 1
+	}
+
+	plotW := float64(hypsometricWidth - 2*hypsometricMargin)
+	plotH := float64(hypsometricHeight - 2*hypsometricMargin)
+	toPixel := func(areaAbove, elev float64) (int, int) {
+		x := hypsometricMargin + areaAbove*plotW
+		y := hypsometricMargin + (maxElev-elev)/(maxElev-minElev)*plotH
+		return int(math.Round(x)), int(math.Round(y))
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, hypsometricWidth, hypsometricHeight))
+	for y := 0; y < hypsometricHeight; y++ {
+		for x := 0; x < hypsometricWidth; x++ {
+			img.Set(x, y, plotBackground)
+		}
+	}
+
+	if minElev < 0 && maxElev > 0 {
+		x0, y0 := toPixel(0, 0)
+		x1, y1 := toPixel(1, 0)
+		drawLine(img, x0, y0, x1, y1, plotSeaLevel)
+	}
+
+	// Axes along the left and bottom of the plot area
+	left, top := toPixel(0, maxElev)
+	right, bottom := toPixel(1, minElev)
+	drawLine(img, left, top, left, bottom, plotAxis)
+	drawLine(img, left, bottom, right, bottom, plotAxis)
+
+	// Earth's curve gives the elevation below each fraction of area
+	for i := 1; i < len(fractions); i++ {
+		x0, y0 := toPixel(1-fractions[i-1], earthElevations[i-1])
+		x1, y1 := toPixel(1-fractions[i], earthElevations[i])
+		drawLine(img, x0, y0, x1, y1, plotEarth)
+	}
+
+	last := float64(max(len(elevations)-1, 1))
+	for i := 1; i < len(elevations); i++ {
+		x0, y0 := toPixel(float64(i-1)/last, elevations[i-1])
+		x1, y1 := toPixel(float64(i)/last, elevations[i])
+		drawLine(img, x0, y0, x1, y1, plotTerrain)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// drawLine draws a one-pixel line between two points using Bresenham's algorithm
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs returns the absolute value of an integer
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,63 @@
+package export
+
+import (
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestExportHypsometricCurve(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 30, Height: 20, Topology: hex.TopologyRegion})
+	tiles, err := terrain.GenerateTerrain(grid, terrain.DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "curve.png")
+	if err := ExportHypsometricCurve(tiles, path); err != nil {
+		t.Fatalf("ExportHypsometricCurve() failed: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("Output is not a valid PNG: %v", err)
+	}
+	if bounds := img.Bounds(); bounds.Dx() != hypsometricWidth || bounds.Dy() != hypsometricHeight {
+		t.Errorf("Expected %dx%d image, got %dx%d", hypsometricWidth, hypsometricHeight, bounds.Dx(), bounds.Dy())
+	}
+
+	// Both curves are drawn
+	found := map[[4]uint32]bool{}
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			found[[4]uint32{r, g, b, a}] = true
+		}
+	}
+	for name, c := range map[string][4]uint32{"earth": rgba(plotEarth), "terrain": rgba(plotTerrain)} {
+		if !found[c] {
+			t.Errorf("No %s curve pixels in the plot", name)
+		}
+	}
+
+	if err := ExportHypsometricCurve(nil, path); err == nil {
+		t.Error("Expected error for empty tiles")
+	}
+}
+
+// rgba returns the 16-bit channels of a color for comparison with decoded pixels
+func rgba(c interface{ RGBA() (r, g, b, a uint32) }) [4]uint32 {
+	r, g, b, a := c.RGBA()
+	return [4]uint32{r, g, b, a}
+}
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+// landmassWater is the neutral color for water when coloring by landmass
+var landmassWater = color.RGBA{90, 100, 110, 255}
+
+// landmassPalette holds distinct hues, cycled by landmass ID
+var landmassPalette = []color.RGBA{
+	{230, 25, 75, 255},
+	{60, 180, 75, 255},
+	{255, 225, 25, 255},
+	{0, 130, 200, 255},
+	{245, 130, 48, 255},
+	{145, 30, 180, 255},
+	{70, 240, 240, 255},
+	{240, 50, 230, 255},
+	{210, 245, 60, 255},
+	{170, 110, 40, 255},
+}
+
+// LandmassColor returns the debug color for a landmass ID from
+// terrain.LabelLandmasses. IDs cycle through a fixed palette and
+// terrain.WaterLandmassID maps to a neutral gray
+func LandmassColor(id int) color.RGBA {
+	if id < 0 {
+		return landmassWater
+	}
+	return landmassPalette[id%len(landmassPalette)]
+}
+
+// RenderLandmasses draws each hex in the color of its landmass, so separate
+// continents stand out at a glance. The image is laid out as in RenderLandWater
+func RenderLandmasses(tiles []*terrain.HexTile, grid *hex.Grid, hexSize float64) *image.RGBA {
+	labels := terrain.LabelLandmasses(tiles, grid)
+
+	return renderHexes(grid, hexSize, func(coord hex.AxialCoord) (color.RGBA, bool) {
+		id, ok := labels[coord]
+		if !ok {
+			return color.RGBA{}, false
+		}
+		return LandmassColor(id), true
+	})
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+	"github.com/sean/hex-map/pkg/terrain"
+)
+
+func TestRenderLandmasses(t *testing.T) {
+	// Two continents separated by a strait of water columns
+	grid := hex.NewGrid(hex.GridConfig{Width: 9, Height: 4, Topology: hex.TopologyRegion})
+	var tiles []*terrain.HexTile
+	for _, coord := range grid.AllCoords() {
+		col, _ := coord.ToOffset()
+		tile := &terrain.HexTile{Coordinates: coord, Elevation: -100}
+		if col <= 2 || col >= 6 {
+			tile.Elevation = 100
+		}
+		tile.ClassifyLandWater(0)
+		tiles = append(tiles, tile)
+	}
+
+	const hexSize = 10.0
+	img := RenderLandmasses(tiles, grid, hexSize)
+	minX, minY, _, _ := grid.PixelBounds(hexSize)
+
+	landColors := make(map[color.RGBA]bool)
+	for _, tile := range tiles {
+		cx, cy := grid.ToPixel(tile.Coordinates, hexSize)
+		got := img.RGBAAt(int(cx-minX), int(cy-minY))
+		if !tile.IsLand {
+			if got != landmassWater {
+				t.Errorf("Water hex %v is %v, expected %v", tile.Coordinates, got, landmassWater)
+			}
+			continue
+		}
+		if got == landmassWater || got == frameBackground {
+			t.Errorf("Land hex %v has non-land color %v", tile.Coordinates, got)
+		}
+		landColors[got] = true
+	}
+
+	if len(landColors) != 2 {
+		t.Errorf("Expected 2 distinct landmass colors, got %d: %v", len(landColors), landColors)
+	}
+}
+
+func TestLandmassColor(t *testing.T) {
+	if LandmassColor(terrain.WaterLandmassID) != landmassWater {
+		t.Error("Expected water to use the neutral color")
+	}
+	for i := 1; i < len(landmassPalette); i++ {
+		if LandmassColor(i) == LandmassColor(i-1) {
+			t.Errorf("Landmasses %d and %d share a color", i-1, i)
+		}
+	}
+	if LandmassColor(len(landmassPalette)) != LandmassColor(0) {
+		t.Error("Expected the palette to cycle")
+	}
+}
@@ -31,39 +31,103 @@ func OffsetToAxial(col, row int) AxialCoord {
 	return AxialCoord{Q: q, R: r}
 }
 
+// Orientation defines how hexagons are laid out in pixel space
+type Orientation int
+
+const (
+	FlatTop   Orientation = iota // Flat edges on top and bottom, columns of hexes
+	PointyTop                    // Vertices on top and bottom, rows of hexes
+)
+
 // ToPixel converts axial coordinates to pixel coordinates
 // Uses flat-top hexagon orientation
 func (c AxialCoord) ToPixel(hexSize float64) (x, y float64) {
+	return c.ToPixelOriented(hexSize, FlatTop)
+}
+
+// ToPixelOriented converts axial coordinates to pixel coordinates of the hex
+// center for the given orientation
+func (c AxialCoord) ToPixelOriented(hexSize float64, orientation Orientation) (x, y float64) {
+	if orientation == PointyTop {
+		x = hexSize * (math.Sqrt(3.0)*float64(c.Q) + math.Sqrt(3.0)/2.0*float64(c.R))
+		y = hexSize * (3.0/2.0 * float64(c.R))
+		return x, y
+	}
+	
 	x = hexSize * (3.0/2.0 * float64(c.Q))
 	y = hexSize * (math.Sqrt(3.0)/2.0*float64(c.Q) + math.Sqrt(3.0)*float64(c.R))
 	return x, y
 }
 
+// CornerOffset returns the offset of corner i (0-5) from a hex's center.
+// Flat-top corners sit at multiples of 60°, pointy-top ones are offset by 30°
+func CornerOffset(orientation Orientation, hexSize float64, i int) (dx, dy float64) {
+	angle := float64(i) * math.Pi / 3.0
+	if orientation == PointyTop {
+		angle += math.Pi / 6.0
+	}
+	return hexSize * math.Cos(angle), hexSize * math.Sin(angle)
+}
+
 // PixelToAxial converts pixel coordinates to axial coordinates
 // Uses flat-top hexagon orientation
 func PixelToAxial(x, y, hexSize float64) AxialCoord {
+	return PixelToAxialOriented(x, y, hexSize, FlatTop)
+}
+
+// PixelToAxialOriented converts pixel coordinates to the axial coordinates of
+// the hex containing them for the given orientation
+func PixelToAxialOriented(x, y, hexSize float64, orientation Orientation) AxialCoord {
+	if orientation == PointyTop {
+		q := (math.Sqrt(3.0)/3.0*x - 1.0/3.0*y) / hexSize
+		r := (2.0/3.0) * y / hexSize
+		return axialRound(q, r)
+	}
+	
 	q := (2.0/3.0) * x / hexSize
 	r := (-1.0/3.0*x + math.Sqrt(3.0)/3.0*y) / hexSize
 	return axialRound(q, r)
 }
 
-// axialRound rounds fractional axial coordinates to the nearest hex
+// roundingEpsilon is how close fractional coordinates must be to count as
+// lying exactly on a hex edge or vertex
+const roundingEpsilon = 1e-9
+
+// axialRound rounds fractional axial coordinates to the nearest hex. Points
+// exactly on an edge or vertex are assigned by a fixed rule that does not
+// depend on where they are in the plane: halves round up, and when two
+// components are equally far from their rounded values the earlier of q, r, s
+// is kept. Floating point error near edges is snapped away first, so the
+// same edge always picks the same hex
 func axialRound(q, r float64) AxialCoord {
+	q = snapHalf(q)
+	r = snapHalf(r)
 	s := -q - r
 	
-	rq := math.Round(q)
-	rr := math.Round(r)
-	rs := math.Round(s)
+	rq := math.Floor(q + 0.5)
+	rr := math.Floor(r + 0.5)
+	rs := math.Floor(s + 0.5)
 	
 	qDiff := math.Abs(rq - q)
 	rDiff := math.Abs(rr - r)
 	sDiff := math.Abs(rs - s)
 	
-	if qDiff > rDiff && qDiff > sDiff {
+	// Differences within roundingEpsilon are ties, so vertices where three
+	// hexes meet are assigned by the fixed order rather than by float noise
+	if qDiff > rDiff+roundingEpsilon && qDiff > sDiff+roundingEpsilon {
 		rq = -rr - rs
-	} else if rDiff > sDiff {
+	} else if rDiff > sDiff+roundingEpsilon {
 		rr = -rq - rs
 	}
 	
 	return AxialCoord{Q: int(rq), R: int(rr)}
-}
\ No newline at end of file
+}
+
+// snapHalf moves values within roundingEpsilon of a half onto the half exactly
+func snapHalf(v float64) float64 {
+	base := math.Floor(v)
+	if math.Abs(v-base-0.5) < roundingEpsilon {
+		return base + 0.5
+	}
+	return v
+}
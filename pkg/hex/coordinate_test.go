@@ -134,4 +134,162 @@ func TestPixelRoundTrip(t *testing.T) {
 				original, x, y, roundTrip)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestPixelRoundTripOrientations tests axial ↔ pixel symmetry for both orientations
+func TestPixelRoundTripOrientations(t *testing.T) {
+	hexSize := 12.5
+	for _, orientation := range []Orientation{FlatTop, PointyTop} {
+		for q := -5; q <= 5; q++ {
+			for r := -5; r <= 5; r++ {
+				original := NewAxialCoord(q, r)
+				x, y := original.ToPixelOriented(hexSize, orientation)
+				roundTrip := PixelToAxialOriented(x, y, hexSize, orientation)
+				if roundTrip != original {
+					t.Errorf("Orientation %d round trip failed: %v → (%f,%f) → %v",
+						orientation, original, x, y, roundTrip)
+				}
+			}
+		}
+	}
+}
+
+// TestPixelToAxialEdges tests that points exactly on hex edges and vertices
+// go to one of the hexes touching them, and that the choice is the same for
+// every hex in the plane
+func TestPixelToAxialEdges(t *testing.T) {
+	hexSize := 10.0
+	origin := NewAxialCoord(0, 0)
+
+	for _, orientation := range []Orientation{FlatTop, PointyTop} {
+		for i := 0; i < 6; i++ {
+			// Edge midpoint between corners i and i+1, and corner i itself
+			x0, y0 := CornerOffset(orientation, hexSize, i)
+			x1, y1 := CornerOffset(orientation, hexSize, (i+1)%6)
+			edgeX, edgeY := (x0+x1)/2, (y0+y1)/2
+
+			// The hex across the edge is centered at twice the midpoint
+			across := PixelToAxialOriented(2*edgeX, 2*edgeY, hexSize, orientation)
+			edgeBase := PixelToAxialOriented(edgeX, edgeY, hexSize, orientation)
+			if edgeBase != origin && edgeBase != across {
+				t.Errorf("Orientation %d edge %d: got %v, expected %v or %v", orientation, i, edgeBase, origin, across)
+			}
+			cornerBase := PixelToAxialOriented(x0, y0, hexSize, orientation)
+			if hexDistance(origin, cornerBase) > 1 {
+				t.Errorf("Orientation %d corner %d: got %v, which does not touch the corner", orientation, i, cornerBase)
+			}
+
+			// Shifting the point by a hex shifts the answer by the same hex
+			for q := -6; q <= 6; q++ {
+				for r := -6; r <= 6; r++ {
+					cx, cy := NewAxialCoord(q, r).ToPixelOriented(hexSize, orientation)
+
+					got := PixelToAxialOriented(cx+edgeX, cy+edgeY, hexSize, orientation)
+					if expected := NewAxialCoord(edgeBase.Q+q, edgeBase.R+r); got != expected {
+						t.Errorf("Orientation %d edge %d of (%d,%d): got %v, expected %v", orientation, i, q, r, got, expected)
+					}
+					got = PixelToAxialOriented(cx+x0, cy+y0, hexSize, orientation)
+					if expected := NewAxialCoord(cornerBase.Q+q, cornerBase.R+r); got != expected {
+						t.Errorf("Orientation %d corner %d of (%d,%d): got %v, expected %v", orientation, i, q, r, got, expected)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestAxialRoundTies tests that exact halves round the same way on both sides of zero
+func TestAxialRoundTies(t *testing.T) {
+	tests := []struct {
+		q, r     float64
+		expected AxialCoord
+	}{
+		{0.5, 0, NewAxialCoord(1, 0)},
+		{-0.5, 0, NewAxialCoord(0, 0)},
+		{0, 0.5, NewAxialCoord(0, 1)},
+		{0, -0.5, NewAxialCoord(0, 0)},
+		{-2.5, 1, NewAxialCoord(-2, 1)},
+		{0.5 - 1e-12, 0, NewAxialCoord(1, 0)}, // Snapped onto the edge
+	}
+
+	for _, tt := range tests {
+		if got := axialRound(tt.q, tt.r); got != tt.expected {
+			t.Errorf("axialRound(%v, %v) = %v, expected %v", tt.q, tt.r, got, tt.expected)
+		}
+	}
+}
+
+// TestPointyTopPixelConversion tests pointy-top hex centers
+func TestPointyTopPixelConversion(t *testing.T) {
+	hexSize := 10.0
+	tests := []struct {
+		axial AxialCoord
+		x, y  float64
+	}{
+		{NewAxialCoord(0, 0), 0, 0},
+		{NewAxialCoord(1, 0), 17.32, 0}, // x = sqrt(3)*hexSize
+		{NewAxialCoord(0, 1), 8.66, 15}, // x = sqrt(3)/2*hexSize, y = 1.5*hexSize
+	}
+
+	for _, test := range tests {
+		x, y := test.axial.ToPixelOriented(hexSize, PointyTop)
+		if math.Abs(x-test.x) > 0.1 || math.Abs(y-test.y) > 0.1 {
+			t.Errorf("ToPixelOriented(%v, PointyTop) = (%f, %f), expected (%f, %f)",
+				test.axial, x, y, test.x, test.y)
+		}
+	}
+
+	// A point just inside a hex edge still maps to that hex
+	if got := PixelToAxialOriented(17.32+7, 0, hexSize, PointyTop); got != NewAxialCoord(1, 0) {
+		t.Errorf("Expected point near edge to map to (1,0), got %v", got)
+	}
+}
+
+// TestGridOrientation tests that grid pixel conversion honors its orientation
+func TestGridOrientation(t *testing.T) {
+	coord := NewAxialCoord(2, 1)
+	flat := NewGrid(GridConfig{Width: 5, Height: 5, Topology: TopologyRegion})
+	pointy := NewGrid(GridConfig{Width: 5, Height: 5, Topology: TopologyRegion, Orientation: PointyTop})
+
+	fx, fy := flat.ToPixel(coord, 10)
+	px, py := pointy.ToPixel(coord, 10)
+	if fx == px && fy == py {
+		t.Error("Expected orientations to produce different pixel positions")
+	}
+
+	if got := pointy.PixelToAxial(px, py, 10); got != coord {
+		t.Errorf("Pointy grid round trip: got %v, expected %v", got, coord)
+	}
+	if got := flat.PixelToAxial(fx, fy, 10); got != coord {
+		t.Errorf("Flat grid round trip: got %v, expected %v", got, coord)
+	}
+}
+
+// TestHexAtPixel tests picking grid hexes from pixel positions
+func TestHexAtPixel(t *testing.T) {
+	hexSize := 10.0
+	for _, orientation := range []Orientation{FlatTop, PointyTop} {
+		grid := NewGrid(GridConfig{Width: 6, Height: 5, Orientation: orientation})
+
+		// Every hex center picks its own hex
+		for _, coord := range grid.AllCoords() {
+			x, y := grid.ToPixel(coord, hexSize)
+			if got, ok := grid.HexAtPixel(x, y, hexSize); !ok || got != coord {
+				t.Errorf("Orientation %d: HexAtPixel at center of %v = %v, %v", orientation, coord, got, ok)
+			}
+		}
+
+		// Pixels beyond the edge of a region grid hit nothing
+		minX, minY, _, _ := grid.PixelBounds(hexSize)
+		if got, ok := grid.HexAtPixel(minX-3*hexSize, minY-3*hexSize, hexSize); ok {
+			t.Errorf("Orientation %d: expected no hex outside the grid, got %v", orientation, got)
+		}
+	}
+
+	// World grids wrap pixels past the edge back onto the map
+	world := NewGrid(GridConfig{Width: 6, Height: 5, Topology: TopologyWorld})
+	x, y := world.ToPixel(OffsetToAxial(6, 2), hexSize)
+	if got, ok := world.HexAtPixel(x, y, hexSize); !ok || got != OffsetToAxial(0, 2) {
+		t.Errorf("Expected a pixel one column past the edge to wrap to %v, got %v, %v", OffsetToAxial(0, 2), got, ok)
+	}
+}
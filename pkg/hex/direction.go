@@ -0,0 +1,50 @@
+package hex
+
+// Direction names one of the six neighbors of a hex. Names are compass
+// directions for the default flat-top layout, with north toward negative pixel
+// y; values follow the order of hexDirections, counter-clockwise from southeast
+type Direction int
+
+const (
+	DirectionSE Direction = iota // +q
+	DirectionNE                  // +q, -r
+	DirectionN                   // -r
+	DirectionNW                  // -q
+	DirectionSW                  // -q, +r
+	DirectionS                   // +r
+)
+
+// Directions lists all six directions in counter-clockwise order
+var Directions = [6]Direction{
+	DirectionSE, DirectionNE, DirectionN, DirectionNW, DirectionSW, DirectionS,
+}
+
+// Neighbor returns the adjacent coordinate in the given direction, ignoring grid bounds
+func (c AxialCoord) Neighbor(d Direction) AxialCoord {
+	offset := hexDirections[((int(d)%6)+6)%6]
+	return AxialCoord{Q: c.Q + offset.Q, R: c.R + offset.R}
+}
+
+// Neighbor returns the neighbor of c in the given direction and whether it
+// exists. World grids wrap the neighbor onto the grid; region grids report
+// false for neighbors outside the grid
+func (g *Grid) Neighbor(c AxialCoord, d Direction) (AxialCoord, bool) {
+	neighbor := c.Neighbor(d)
+	if g.config.Topology == TopologyWorld {
+		return g.WrapCoord(neighbor), true
+	}
+	return neighbor, g.IsValid(neighbor)
+}
+
+// DirectionBetween returns the direction from one hex to an adjacent hex, and
+// false if to is not an immediate neighbor of from. Coordinates are compared
+// directly, so neighbors across a world wrap seam are not recognized
+func DirectionBetween(from, to AxialCoord) (Direction, bool) {
+	delta := AxialCoord{Q: to.Q - from.Q, R: to.R - from.R}
+	for i, offset := range hexDirections {
+		if offset == delta {
+			return Directions[i], true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,72 @@
+package hex
+
+import "testing"
+
+func TestNeighborDirections(t *testing.T) {
+	center := NewAxialCoord(3, 2)
+	seen := make(map[AxialCoord]bool)
+
+	for _, d := range Directions {
+		neighbor := center.Neighbor(d)
+		if dist := hexDistance(center, neighbor); dist != 1 {
+			t.Errorf("Direction %d: neighbor %v is at distance %d", d, neighbor, dist)
+		}
+		seen[neighbor] = true
+	}
+
+	if len(seen) != 6 {
+		t.Errorf("Expected 6 distinct neighbors, got %d", len(seen))
+	}
+
+	// Directions are ordered as in Neighbors and step counter-clockwise
+	for i, d := range Directions {
+		if got := center.Neighbor(d).RotateAround(center, 1); got != center.Neighbor(Directions[(i+1)%6]) {
+			t.Errorf("Rotating direction %d gave %v", d, got)
+		}
+	}
+
+	if center.Neighbor(DirectionN) != NewAxialCoord(3, 1) || center.Neighbor(DirectionS) != NewAxialCoord(3, 3) {
+		t.Error("North and south should step along r")
+	}
+}
+
+func TestGridNeighbor(t *testing.T) {
+	region := NewGrid(GridConfig{Width: 10, Height: 8, Topology: TopologyRegion})
+	world := NewGrid(GridConfig{Width: 10, Height: 8, Topology: TopologyWorld})
+	corner := NewAxialCoord(0, 0)
+
+	if _, ok := region.Neighbor(corner, DirectionN); ok {
+		t.Error("Region corner should have no northern neighbor")
+	}
+	if neighbor, ok := region.Neighbor(corner, DirectionS); !ok || neighbor != NewAxialCoord(0, 1) {
+		t.Errorf("Region southern neighbor = %v, %v", neighbor, ok)
+	}
+
+	for _, d := range Directions {
+		neighbor, ok := world.Neighbor(corner, d)
+		if !ok {
+			t.Errorf("World neighbor in direction %d should always exist", d)
+		}
+		if !world.IsValid(neighbor) || corner.DistanceTo(neighbor, world) != 1 {
+			t.Errorf("World neighbor %v in direction %d is not an adjacent grid hex", neighbor, d)
+		}
+	}
+}
+
+func TestDirectionBetween(t *testing.T) {
+	from := NewAxialCoord(-2, 5)
+
+	for _, d := range Directions {
+		got, ok := DirectionBetween(from, from.Neighbor(d))
+		if !ok || got != d {
+			t.Errorf("DirectionBetween for direction %d = %d, %v", d, got, ok)
+		}
+	}
+
+	nonNeighbors := []AxialCoord{from, NewAxialCoord(0, 5), NewAxialCoord(-1, 3), NewAxialCoord(-3, 7)}
+	for _, to := range nonNeighbors {
+		if _, ok := DirectionBetween(from, to); ok {
+			t.Errorf("DirectionBetween(%v, %v) should report no direction", from, to)
+		}
+	}
+}
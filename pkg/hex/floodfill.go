@@ -0,0 +1,34 @@
+package hex
+
+// FloodFill returns all hexes reachable from start through neighbors for which
+// match returns true, in breadth-first order. The start hex itself must match.
+// Respects topology: world maps fill across wrapped edges
+func (g *Grid) FloodFill(start AxialCoord, match func(AxialCoord) bool) []AxialCoord {
+	if !g.IsValid(start) {
+		return nil
+	}
+
+	start = g.WrapCoord(start)
+	if !match(start) {
+		return nil
+	}
+
+	visited := map[AxialCoord]bool{start: true}
+	region := []AxialCoord{start}
+
+	// The region slice doubles as the BFS queue
+	for i := 0; i < len(region); i++ {
+		for _, neighbor := range g.Neighbors(region[i]) {
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+
+			if match(neighbor) {
+				region = append(region, neighbor)
+			}
+		}
+	}
+
+	return region
+}
@@ -0,0 +1,59 @@
+package hex
+
+import (
+	"testing"
+)
+
+// TestFloodFillSeparateRegions tests that flood fill stays within one connected region
+func TestFloodFillSeparateRegions(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 6, Topology: TopologyRegion})
+
+	// Two land masses: columns 1-2 and columns 6-7, separated by water
+	isLand := func(c AxialCoord) bool {
+		col, _ := c.ToOffset()
+		return (col >= 1 && col <= 2) || (col >= 6 && col <= 7)
+	}
+
+	west := grid.FloodFill(OffsetToAxial(1, 2), isLand)
+	east := grid.FloodFill(OffsetToAxial(7, 3), isLand)
+
+	if len(west) != 12 {
+		t.Errorf("Expected 12 hexes in western land mass, got %d", len(west))
+	}
+	if len(east) != 12 {
+		t.Errorf("Expected 12 hexes in eastern land mass, got %d", len(east))
+	}
+
+	seen := make(map[AxialCoord]bool)
+	for _, coord := range west {
+		col, _ := coord.ToOffset()
+		if col > 2 {
+			t.Errorf("Western fill leaked into column %d at %v", col, coord)
+		}
+		if seen[coord] {
+			t.Errorf("Flood fill returned duplicate hex %v", coord)
+		}
+		seen[coord] = true
+	}
+
+	// Starting on a non-matching hex yields nothing
+	if region := grid.FloodFill(OffsetToAxial(4, 2), isLand); len(region) != 0 {
+		t.Errorf("Expected empty fill from water hex, got %d hexes", len(region))
+	}
+}
+
+// TestFloodFillWorldWrapping tests that flood fill crosses wrapped edges on world maps
+func TestFloodFillWorldWrapping(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 6, Topology: TopologyWorld})
+
+	// Land on both the first and last columns connects across the seam
+	isLand := func(c AxialCoord) bool {
+		col, _ := c.ToOffset()
+		return col == 0 || col == 9
+	}
+
+	region := grid.FloodFill(OffsetToAxial(0, 0), isLand)
+	if len(region) != 12 {
+		t.Errorf("Expected 12 hexes connected across the wrap seam, got %d", len(region))
+	}
+}
@@ -1,6 +1,7 @@
 package hex
 
 import (
+	"math"
 	"testing"
 )
 
@@ -114,6 +115,49 @@ func TestGridAllCoords(t *testing.T) {
 	}
 }
 
+// TestGridForEachCoord tests that ForEachCoord visits the same coordinates as AllCoords
+func TestGridForEachCoord(t *testing.T) {
+	configs := []GridConfig{
+		{Width: 7, Height: 5, Topology: TopologyRegion},
+		{Width: 8, Height: 6, Topology: TopologyWorld},
+		{Width: 9, Shape: ShapeHexagon},
+	}
+
+	for _, config := range configs {
+		grid := NewGrid(config)
+		expected := grid.AllCoords()
+
+		var visited []AxialCoord
+		grid.ForEachCoord(func(coord AxialCoord) {
+			visited = append(visited, coord)
+		})
+
+		if len(visited) != len(expected) {
+			t.Fatalf("%+v: visited %d coordinates, expected %d", config, len(visited), len(expected))
+		}
+		for i := range visited {
+			if visited[i] != expected[i] {
+				t.Errorf("%+v: coordinate %d is %v, expected %v", config, i, visited[i], expected[i])
+			}
+		}
+	}
+}
+
+// TestGridAllCoordsCopy tests that modifying AllCoords output leaves the grid intact
+func TestGridAllCoordsCopy(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 4, Height: 3, Topology: TopologyRegion})
+
+	coords := grid.AllCoords()
+	first := coords[0]
+	coords[0] = NewAxialCoord(99, 99)
+	_ = append(coords[:1], NewAxialCoord(-5, -5))
+
+	again := grid.AllCoords()
+	if len(again) != 12 || again[0] != first || again[1] == NewAxialCoord(-5, -5) {
+		t.Errorf("AllCoords cache was modified through a returned slice: %v", again)
+	}
+}
+
 // TestGridBoundaryHandling tests that grids handle boundary conditions correctly
 func TestGridBoundaryHandling(t *testing.T) {
 	config := GridConfig{Width: 5, Height: 3, Topology: TopologyRegion}
@@ -183,4 +227,265 @@ func TestWorldGridWrapping(t *testing.T) {
 			t.Errorf("Wrapped coordinate %v should be valid", test.wrapped)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestGridShapes tests coordinate counts and storage for each grid shape
+func TestGridShapes(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   GridConfig
+		expected int
+	}{
+		{"rectangle", GridConfig{Width: 7, Height: 5, Shape: ShapeRectangle}, 35},
+		{"hexagon radius 3", GridConfig{Width: 7, Shape: ShapeHexagon}, 1 + 3*3*4},
+		{"hexagon radius 4", GridConfig{Width: 9, Shape: ShapeHexagon}, 1 + 3*4*5},
+		{"rhombus", GridConfig{Width: 6, Height: 4, Shape: ShapeRhombus}, 24},
+		{"triangle", GridConfig{Width: 5, Shape: ShapeTriangle}, 15},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grid := NewGrid(test.config)
+			coords := grid.AllCoords()
+			if len(coords) != test.expected {
+				t.Fatalf("Expected %d coordinates, got %d", test.expected, len(coords))
+			}
+
+			// Every coordinate is valid and can store a value
+			for i, coord := range coords {
+				if !grid.IsValid(coord) {
+					t.Errorf("AllCoords returned invalid coordinate %v", coord)
+				}
+				grid.Set(coord, i)
+			}
+			for i, coord := range coords {
+				if grid.Get(coord) != i {
+					t.Errorf("Get(%v) = %v, expected %d", coord, grid.Get(coord), i)
+				}
+			}
+		})
+	}
+
+	// Hexagon shapes contain exactly the hexes within the radius of the center
+	grid := NewGrid(GridConfig{Width: 7, Shape: ShapeHexagon})
+	center := OffsetToAxial(3, 3)
+	for q := -10; q <= 10; q++ {
+		for r := -10; r <= 10; r++ {
+			coord := NewAxialCoord(q, r)
+			inside := hexDistance(center, coord) <= 3
+			if grid.IsValid(coord) != inside {
+				t.Errorf("IsValid(%v) = %v, expected %v", coord, grid.IsValid(coord), inside)
+			}
+		}
+	}
+}
+
+// TestGridConfigValidate tests that only rectangles may wrap
+func TestGridConfigValidate(t *testing.T) {
+	if err := (GridConfig{Width: 5, Height: 5, Topology: TopologyWorld}).Validate(); err != nil {
+		t.Errorf("Expected world rectangle to be valid, got %v", err)
+	}
+	if err := (GridConfig{Width: 5, Topology: TopologyWorld, Shape: ShapeHexagon}).Validate(); err != ErrWorldRequiresRectangle {
+		t.Errorf("Expected ErrWorldRequiresRectangle, got %v", err)
+	}
+	if err := (GridConfig{Width: 5, Shape: Shape(42)}).Validate(); err != ErrUnknownShape {
+		t.Errorf("Expected ErrUnknownShape, got %v", err)
+	}
+
+	// NewGridE reports invalid configurations as errors
+	if grid, err := NewGridE(GridConfig{Width: 5, Topology: TopologyWorld, Shape: ShapeHexagon}); err != ErrWorldRequiresRectangle || grid != nil {
+		t.Errorf("Expected nil grid and ErrWorldRequiresRectangle, got %v, %v", grid, err)
+	}
+	if grid, err := NewGridE(GridConfig{Width: 5, Height: 5}); err != nil || len(grid.AllCoords()) != 25 {
+		t.Errorf("Expected a 25-hex grid, got error %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected NewGrid to panic for a wrapping hexagon")
+		}
+	}()
+	NewGrid(GridConfig{Width: 5, Topology: TopologyWorld, Shape: ShapeHexagon})
+}
+
+// TestSubgrid tests extracting a window of a grid
+func TestSubgrid(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 20, Height: 12, Topology: TopologyWorld})
+
+	sub, err := grid.Subgrid(4, 3, 8, 5)
+	if err != nil {
+		t.Fatalf("Subgrid() failed: %v", err)
+	}
+	if len(sub.AllCoords()) != 8*5 {
+		t.Errorf("Expected %d coordinates, got %d", 8*5, len(sub.AllCoords()))
+	}
+	if sub.Topology() != TopologyRegion {
+		t.Error("Expected subgrid to use region topology")
+	}
+
+	// Adjacency is preserved after re-basing to the window origin
+	origCenter := OffsetToAxial(7, 6)
+	subCenter := OffsetToAxial(3, 3)
+	if len(origCenter.Neighbors(grid)) != len(subCenter.Neighbors(sub)) {
+		t.Fatal("Expected interior hexes to keep all neighbors")
+	}
+	for _, n := range subCenter.Neighbors(sub) {
+		col, row := n.ToOffset()
+		if origCenter.DistanceTo(OffsetToAxial(col+4, row+3), grid) != 1 {
+			t.Errorf("Subgrid neighbor %v is not adjacent in the original grid", n)
+		}
+	}
+
+	errorCases := []struct {
+		name                          string
+		minCol, minRow, width, height int
+		expected                      error
+	}{
+		{"past right edge", 16, 0, 6, 4, ErrSubgridBounds},
+		{"negative row", 0, -1, 4, 4, ErrSubgridBounds},
+		{"empty", 0, 0, 0, 4, ErrSubgridBounds},
+		{"odd column", 3, 0, 4, 4, ErrSubgridOddColumn},
+	}
+	for _, tc := range errorCases {
+		if _, err := grid.Subgrid(tc.minCol, tc.minRow, tc.width, tc.height); err != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, err)
+		}
+	}
+}
+
+// TestGridNeighborsCache tests that cached neighbor lists match the uncached ones
+func TestGridNeighborsCache(t *testing.T) {
+	configs := []GridConfig{
+		{Width: 7, Height: 5, Topology: TopologyRegion},
+		{Width: 8, Height: 6, Topology: TopologyWorld},
+		{Width: 9, Shape: ShapeHexagon},
+	}
+
+	for _, config := range configs {
+		grid := NewGrid(config)
+		probes := append(grid.AllCoords(), NewAxialCoord(-4, 2), NewAxialCoord(30, -30))
+		for _, coord := range probes {
+			uncached := grid.appendNeighbors(nil, coord)
+			got := grid.Neighbors(coord)
+			if len(got) != len(uncached) {
+				t.Fatalf("%+v: Neighbors(%v) = %v, expected %v", config, coord, got, uncached)
+			}
+			for i := range got {
+				if got[i] != uncached[i] {
+					t.Errorf("%+v: Neighbors(%v) = %v, expected %v", config, coord, got, uncached)
+					break
+				}
+			}
+		}
+	}
+
+	// Appending to a shared list must not overwrite the next hex's neighbors
+	grid := NewGrid(GridConfig{Width: 4, Height: 4, Topology: TopologyRegion})
+	first, second := grid.AllCoords()[0], grid.AllCoords()[1]
+	before := append([]AxialCoord(nil), grid.Neighbors(second)...)
+	_ = append(grid.Neighbors(first), NewAxialCoord(99, 99))
+	for i, coord := range grid.Neighbors(second) {
+		if coord != before[i] {
+			t.Fatalf("Neighbors(%v) changed after appending to Neighbors(%v)", second, first)
+		}
+	}
+
+	// AxialCoord.Neighbors hands out a private copy
+	mine := first.Neighbors(grid)
+	mine[0] = NewAxialCoord(99, 99)
+	if grid.Neighbors(first)[0] == mine[0] {
+		t.Error("Modifying AxialCoord.Neighbors output changed the grid cache")
+	}
+}
+
+func BenchmarkAllCoords(b *testing.B) {
+	grid := NewGrid(GridConfig{Width: 256, Height: 256, Topology: TopologyWorld})
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		grid.AllCoords()
+	}
+}
+
+func BenchmarkAllCoordsIteration(b *testing.B) {
+	grid := NewGrid(GridConfig{Width: 256, Height: 256, Topology: TopologyWorld})
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, coord := range grid.AllCoords() {
+			sum += coord.Q
+		}
+		_ = sum
+	}
+}
+
+func BenchmarkForEachCoord(b *testing.B) {
+	grid := NewGrid(GridConfig{Width: 256, Height: 256, Topology: TopologyWorld})
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		grid.ForEachCoord(func(coord AxialCoord) {
+			sum += coord.Q
+		})
+		_ = sum
+	}
+}
+
+// TestGridBounds tests the offset bounding box of several grid shapes
+func TestGridBounds(t *testing.T) {
+	tests := []struct {
+		config                         GridConfig
+		minCol, minRow, maxCol, maxRow int
+	}{
+		{GridConfig{Width: 10, Height: 8}, 0, 0, 9, 7},
+		{GridConfig{Width: 1, Height: 1, Topology: TopologyWorld}, 0, 0, 0, 0},
+		{GridConfig{Width: 7, Shape: ShapeHexagon}, 0, 0, 6, 6},
+		{GridConfig{Width: 2, Height: 2, Shape: ShapeRhombus}, 0, 0, 1, 2},
+	}
+
+	for _, tt := range tests {
+		minCol, minRow, maxCol, maxRow := NewGrid(tt.config).Bounds()
+		if minCol != tt.minCol || minRow != tt.minRow || maxCol != tt.maxCol || maxRow != tt.maxRow {
+			t.Errorf("%+v: Bounds() = (%d,%d,%d,%d), expected (%d,%d,%d,%d)", tt.config,
+				minCol, minRow, maxCol, maxRow, tt.minCol, tt.minRow, tt.maxCol, tt.maxRow)
+		}
+	}
+}
+
+// TestGridPixelBounds tests that pixel bounds tightly enclose every hex vertex
+func TestGridPixelBounds(t *testing.T) {
+	const hexSize = 10.0
+	const epsilon = 1e-9
+
+	for _, orientation := range []Orientation{FlatTop, PointyTop} {
+		for _, shape := range []Shape{ShapeRectangle, ShapeHexagon} {
+			grid := NewGrid(GridConfig{Width: 9, Height: 6, Orientation: orientation, Shape: shape})
+			minX, minY, maxX, maxY := grid.PixelBounds(hexSize)
+
+			touched := [4]bool{}
+			grid.ForEachCoord(func(coord AxialCoord) {
+				cx, cy := grid.ToPixel(coord, hexSize)
+				for i := 0; i < 6; i++ {
+					dx, dy := CornerOffset(orientation, hexSize, i)
+					x, y := cx+dx, cy+dy
+					if x < minX-epsilon || x > maxX+epsilon || y < minY-epsilon || y > maxY+epsilon {
+						t.Errorf("orientation %d shape %d: vertex (%f,%f) of %v outside bounds", orientation, shape, x, y, coord)
+					}
+					touched[0] = touched[0] || math.Abs(x-minX) < epsilon
+					touched[1] = touched[1] || math.Abs(y-minY) < epsilon
+					touched[2] = touched[2] || math.Abs(x-maxX) < epsilon
+					touched[3] = touched[3] || math.Abs(y-maxY) < epsilon
+				}
+			})
+
+			if touched != [4]bool{true, true, true, true} {
+				t.Errorf("orientation %d shape %d: bounds are not tight: %v", orientation, shape, touched)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package hex
+
+import "encoding/json"
+
+// gridJSON is the serialized form of a grid's configuration. Stored tile
+// values are not included
+type gridJSON struct {
+	Width             int         `json:"width"`
+	Height            int         `json:"height"`
+	Topology          Topology    `json:"topology"`    // 0 = region, 1 = world
+	Shape             Shape       `json:"shape"`       // 0 = rectangle, 1 = hexagon, 2 = rhombus, 3 = triangle
+	Orientation       Orientation `json:"orientation"` // 0 = flat top, 1 = pointy top
+	SphericalDistance bool        `json:"sphericalDistance,omitempty"`
+}
+
+// MarshalJSON encodes the grid's configuration so the same grid can be rebuilt
+// with UnmarshalJSON. Values stored with Set are omitted
+func (g *Grid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gridJSON{
+		Width:             g.config.Width,
+		Height:            g.config.Height,
+		Topology:          g.config.Topology,
+		Shape:             g.config.Shape,
+		Orientation:       g.config.Orientation,
+		SphericalDistance: g.config.SphericalDistance,
+	})
+}
+
+// UnmarshalJSON rebuilds an empty grid from a configuration written by
+// MarshalJSON. Invalid configurations return the Validate error
+func (g *Grid) UnmarshalJSON(data []byte) error {
+	var stored gridJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	config := GridConfig{
+		Width:             stored.Width,
+		Height:            stored.Height,
+		Topology:          stored.Topology,
+		Shape:             stored.Shape,
+		Orientation:       stored.Orientation,
+		SphericalDistance: stored.SphericalDistance,
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	*g = *NewGrid(config)
+	return nil
+}
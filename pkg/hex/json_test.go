@@ -0,0 +1,83 @@
+package hex
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGridJSONRoundTrip(t *testing.T) {
+	configs := []GridConfig{
+		{Width: 12, Height: 8, Topology: TopologyRegion},
+		{Width: 16, Height: 10, Topology: TopologyWorld, Orientation: PointyTop, SphericalDistance: true},
+		{Width: 9, Shape: ShapeHexagon, Orientation: PointyTop},
+		{Width: 5, Height: 3, Shape: ShapeRhombus},
+		{Width: 6, Shape: ShapeTriangle},
+	}
+
+	for _, config := range configs {
+		grid := NewGrid(config)
+		grid.Set(grid.AllCoords()[0], "payload")
+
+		data, err := json.Marshal(grid)
+		if err != nil {
+			t.Fatalf("%+v: Marshal failed: %v", config, err)
+		}
+
+		var restored Grid
+		if err := json.Unmarshal(data, &restored); err != nil {
+			t.Fatalf("%+v: Unmarshal failed: %v", config, err)
+		}
+
+		if restored.Config() != config {
+			t.Errorf("Config changed in round trip: %+v became %+v", config, restored.Config())
+		}
+		if len(restored.AllCoords()) != len(grid.AllCoords()) {
+			t.Errorf("%+v: expected %d coordinates, got %d", config, len(grid.AllCoords()), len(restored.AllCoords()))
+		}
+		if restored.Get(grid.AllCoords()[0]) != nil {
+			t.Errorf("%+v: expected stored values to be omitted", config)
+		}
+	}
+}
+
+func TestGridJSONInvalid(t *testing.T) {
+	var grid Grid
+	if err := json.Unmarshal([]byte(`{"width":5,"height":5,"topology":1,"shape":1}`), &grid); err != ErrWorldRequiresRectangle {
+		t.Errorf("Expected ErrWorldRequiresRectangle, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"width":"wide"}`), &grid); err == nil {
+		t.Error("Expected an error for malformed JSON")
+	}
+}
+
+// TestGridJSONInvalidConfig tests that UnmarshalJSON rejects configurations
+// NewGrid can't build instead of panicking
+func TestGridJSONInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want error
+	}{
+		{"zero width world", `{"width":0,"height":5,"topology":1}`, ErrGridSize},
+		{"zero height rectangle", `{"width":5,"height":0}`, ErrGridSize},
+		{"negative width hexagon", `{"width":-3,"shape":1}`, ErrGridSize},
+		{"unknown topology", `{"width":5,"height":5,"topology":7}`, ErrUnknownTopology},
+		{"unknown orientation", `{"width":5,"height":5,"orientation":2}`, ErrUnknownOrientation},
+		{"unknown shape", `{"width":5,"height":5,"shape":9}`, ErrUnknownShape},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var grid Grid
+			if err := json.Unmarshal([]byte(tt.json), &grid); err != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Hexagons are sized by width alone, so a missing height is fine
+	var grid Grid
+	if err := json.Unmarshal([]byte(`{"width":5,"shape":1}`), &grid); err != nil {
+		t.Errorf("Expected hexagon without height to be valid, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package hex
+
+// HexLine returns the hexes on a straight line from one coordinate to another,
+// inclusive of both ends, with consecutive hexes adjacent. Sample points are
+// nudged slightly so lines running exactly along hex edges resolve consistently.
+// Coordinates are not wrapped or bounds-checked
+func HexLine(from, to AxialCoord) []AxialCoord {
+	distance := hexDistance(from, to)
+	line := make([]AxialCoord, distance+1)
+	line[0] = from
+	if distance == 0 {
+		return line
+	}
+
+	const nudge = 1e-6
+	for i := 1; i < distance; i++ {
+		t := float64(i) / float64(distance)
+		q := float64(from.Q)*(1-t) + float64(to.Q)*t + nudge
+		r := float64(from.R)*(1-t) + float64(to.R)*t + nudge
+		line[i] = axialRound(q, r)
+	}
+	line[distance] = to
+
+	return line
+}
@@ -0,0 +1,42 @@
+package hex
+
+import "testing"
+
+// TestHexLine tests straight lines between hexes
+func TestHexLine(t *testing.T) {
+	tests := []struct {
+		from, to AxialCoord
+	}{
+		{NewAxialCoord(0, 0), NewAxialCoord(0, 0)},
+		{NewAxialCoord(0, 0), NewAxialCoord(5, 0)},
+		{NewAxialCoord(0, 0), NewAxialCoord(3, -6)},
+		{NewAxialCoord(-2, 4), NewAxialCoord(4, -1)},
+		{NewAxialCoord(0, 0), NewAxialCoord(2, -1)}, // runs along hex edges
+	}
+
+	for _, test := range tests {
+		line := HexLine(test.from, test.to)
+		distance := hexDistance(test.from, test.to)
+
+		if len(line) != distance+1 {
+			t.Errorf("%v→%v: expected %d hexes, got %d", test.from, test.to, distance+1, len(line))
+			continue
+		}
+		if line[0] != test.from || line[len(line)-1] != test.to {
+			t.Errorf("%v→%v: line %v doesn't span the endpoints", test.from, test.to, line)
+		}
+		for i := 0; i+1 < len(line); i++ {
+			if hexDistance(line[i], line[i+1]) != 1 {
+				t.Errorf("%v→%v: %v and %v are not adjacent", test.from, test.to, line[i], line[i+1])
+			}
+		}
+	}
+
+	// Straight lines along an axis pass through every hex in between
+	line := HexLine(NewAxialCoord(0, 0), NewAxialCoord(0, 4))
+	for i, coord := range line {
+		if coord != NewAxialCoord(0, i) {
+			t.Errorf("Expected (0,%d) at step %d, got %v", i, i, coord)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package hex
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+// ErrInvalidStepCost is returned when a weighted path search meets a step cost
+// that is NaN or below the minimum step cost, or the minimum itself is not
+// positive. Such costs would make the A* heuristic overestimate
+var ErrInvalidStepCost = errors.New("step cost must be at least the positive minimum step cost")
+
+// FindPath finds the shortest path between two coordinates using A* search.
+// Hexes for which passable returns false are routed around. The returned path
+// includes both endpoints; the boolean reports whether a path was found.
+// For world topology, wrapped neighbors are considered
+func (g *Grid) FindPath(from, to AxialCoord, passable func(AxialCoord) bool) ([]AxialCoord, bool) {
+	cost := func(c AxialCoord) float64 {
+		if passable(c) {
+			return 1
+		}
+		return math.Inf(1)
+	}
+
+	// Every cost is 1 or infinite, so the search can't fail on a bad cost
+	path, _, found, _ := g.findPath(from, to, cost, 1)
+	return path, found
+}
+
+// FindWeightedPath finds the lowest-cost path between two coordinates using A*.
+// cost returns the cost of entering a hex; math.Inf(1) marks it impassable.
+// minStepCost is a lower bound on every finite cost, which keeps the search
+// heuristic admissible; passing the true minimum gives the fastest search.
+// Returns the path, its total cost, and whether a path was found. A
+// non-positive or NaN minimum, or a cost below it, returns ErrInvalidStepCost
+func (g *Grid) FindWeightedPath(from, to AxialCoord, cost func(AxialCoord) float64, minStepCost float64) ([]AxialCoord, float64, bool, error) {
+	if !(minStepCost > 0) || math.IsInf(minStepCost, 1) {
+		return nil, 0, false, ErrInvalidStepCost
+	}
+	return g.findPath(from, to, cost, minStepCost)
+}
+
+// findPath runs A* using the hex step distance scaled by minStepCost as heuristic
+func (g *Grid) findPath(from, to AxialCoord, cost func(AxialCoord) float64, minStepCost float64) ([]AxialCoord, float64, bool, error) {
+	if !g.IsValid(from) || !g.IsValid(to) {
+		return nil, 0, false, nil
+	}
+
+	from = g.WrapCoord(from)
+	to = g.WrapCoord(to)
+
+	if math.IsInf(cost(to), 1) {
+		return nil, 0, false, nil
+	}
+	if from == to {
+		return []AxialCoord{from}, 0, true, nil
+	}
+
+	// Step distance never overestimates the steps left, unlike spherical
+	// distance, so the heuristic stays admissible on every grid
+	heuristic := func(c AxialCoord) float64 {
+		return float64(g.stepDistance(c, to)) * minStepCost
+	}
+
+	// Search state lives in slices indexed by tile storage position, which
+	// is much cheaper than hashing coordinates on large grids
+	cells := g.cellCount()
+	cameFrom := make([]AxialCoord, cells)
+	costSoFar := make([]float64, cells)
+	for i := range costSoFar {
+		costSoFar[i] = math.Inf(1)
+	}
+	closed := make([]bool, cells)
+
+	fromIndex, _ := g.cellIndex(from)
+	toIndex, _ := g.cellIndex(to)
+	costSoFar[fromIndex] = 0
+
+	open := &pathQueue{}
+	heap.Push(open, &pathNode{coord: from, priority: heuristic(from)})
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(*pathNode).coord
+		if current == to {
+			return reconstructPath(g, cameFrom, from, to), costSoFar[toIndex], true, nil
+		}
+		currentIndex, _ := g.cellIndex(current)
+		if closed[currentIndex] {
+			continue
+		}
+		closed[currentIndex] = true
+
+		for _, next := range g.Neighbors(current) {
+			nextIndex, _ := g.cellIndex(next)
+			if closed[nextIndex] {
+				continue
+			}
+
+			stepCost := cost(next)
+			if math.IsInf(stepCost, 1) {
+				continue
+			}
+			if !(stepCost >= minStepCost) {
+				return nil, 0, false, ErrInvalidStepCost
+			}
+
+			newCost := costSoFar[currentIndex] + stepCost
+			if newCost >= costSoFar[nextIndex] {
+				continue
+			}
+
+			costSoFar[nextIndex] = newCost
+			cameFrom[nextIndex] = current
+			heap.Push(open, &pathNode{coord: next, priority: newCost + heuristic(next)})
+		}
+	}
+
+	return nil, 0, false, nil
+}
+
+// reconstructPath walks the cameFrom links back from the goal to the start
+func reconstructPath(g *Grid, cameFrom []AxialCoord, from, to AxialCoord) []AxialCoord {
+	path := []AxialCoord{to}
+	for current := to; current != from; {
+		i, _ := g.cellIndex(current)
+		current = cameFrom[i]
+		path = append(path, current)
+	}
+
+	// Reverse so the path runs from start to goal
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// pathNode is an entry in the A* open set
+type pathNode struct {
+	coord    AxialCoord
+	priority float64
+	order    int // insertion order, used to break ties deterministically
+}
+
+// pathQueue is a min-heap of path nodes ordered by priority
+type pathQueue struct {
+	nodes   []*pathNode
+	counter int
+}
+
+func (q *pathQueue) Len() int { return len(q.nodes) }
+
+func (q *pathQueue) Less(i, j int) bool {
+	if q.nodes[i].priority != q.nodes[j].priority {
+		return q.nodes[i].priority < q.nodes[j].priority
+	}
+	return q.nodes[i].order < q.nodes[j].order
+}
+
+func (q *pathQueue) Swap(i, j int) { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+
+func (q *pathQueue) Push(x interface{}) {
+	node := x.(*pathNode)
+	node.order = q.counter
+	q.counter++
+	q.nodes = append(q.nodes, node)
+}
+
+func (q *pathQueue) Pop() interface{} {
+	last := len(q.nodes) - 1
+	node := q.nodes[last]
+	q.nodes = q.nodes[:last]
+	return node
+}
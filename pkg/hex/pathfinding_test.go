@@ -0,0 +1,274 @@
+package hex
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// wallGrid returns a region grid with a vertical wall of impassable hexes at
+// column 5, leaving a gap only at the given row (or no gap if gapRow < 0)
+func wallGrid(gapRow int) (*Grid, func(AxialCoord) bool) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 10, Topology: TopologyRegion})
+
+	passable := func(c AxialCoord) bool {
+		col, row := c.ToOffset()
+		return col != 5 || row == gapRow
+	}
+
+	return grid, passable
+}
+
+// TestFindPathAroundWall tests that A* detours around a wall of impassable hexes
+func TestFindPathAroundWall(t *testing.T) {
+	grid, passable := wallGrid(9)
+	from := OffsetToAxial(2, 2)
+	to := OffsetToAxial(8, 2)
+
+	path, found := grid.FindPath(from, to, passable)
+	if !found {
+		t.Fatal("Expected a path through the gap in the wall")
+	}
+
+	if path[0] != from || path[len(path)-1] != to {
+		t.Errorf("Path should run from %v to %v, got %v to %v",
+			from, to, path[0], path[len(path)-1])
+	}
+
+	for i, coord := range path {
+		if !passable(coord) {
+			t.Errorf("Path step %d at %v is impassable", i, coord)
+		}
+		if i > 0 && path[i-1].DistanceTo(coord, grid) != 1 {
+			t.Errorf("Path not connected at step %d: %v to %v", i, path[i-1], coord)
+		}
+	}
+
+	// The wall forces a detour longer than the direct distance
+	direct := from.DistanceTo(to, grid)
+	if len(path)-1 <= direct {
+		t.Errorf("Expected detour longer than direct distance %d, got %d steps",
+			direct, len(path)-1)
+	}
+
+	// With no obstacles, A* should find a path of exactly the hex distance
+	open := func(AxialCoord) bool { return true }
+	path, found = grid.FindPath(from, to, open)
+	if !found || len(path)-1 != direct {
+		t.Errorf("Unobstructed path should have %d steps, got %d (found=%v)",
+			direct, len(path)-1, found)
+	}
+}
+
+// TestFindPathNoPath tests that FindPath reports failure when the goal is unreachable
+func TestFindPathNoPath(t *testing.T) {
+	grid, passable := wallGrid(-1)
+
+	path, found := grid.FindPath(OffsetToAxial(2, 2), OffsetToAxial(8, 2), passable)
+	if found {
+		t.Errorf("Expected no path through a solid wall, got %v", path)
+	}
+
+	// An impassable goal is never reachable
+	_, found = grid.FindPath(OffsetToAxial(2, 2), OffsetToAxial(5, 2), passable)
+	if found {
+		t.Error("Expected no path to an impassable goal")
+	}
+
+	// Start equal to goal is a trivial path
+	path, found = grid.FindPath(OffsetToAxial(2, 2), OffsetToAxial(2, 2), passable)
+	if !found || len(path) != 1 {
+		t.Errorf("Expected single-hex path from a hex to itself, got %v", path)
+	}
+}
+
+// TestFindPathWorldWrapping tests that A* uses wrapped neighbors on world maps
+func TestFindPathWorldWrapping(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 8, Topology: TopologyWorld})
+	open := func(AxialCoord) bool { return true }
+
+	from := OffsetToAxial(0, 4)
+	to := OffsetToAxial(9, 4)
+
+	path, found := grid.FindPath(from, to, open)
+	if !found {
+		t.Fatal("Expected a path on an open world map")
+	}
+
+	if len(path) > 3 {
+		t.Errorf("Expected wrapped path of at most 2 steps, got %d: %v", len(path)-1, path)
+	}
+
+	for _, coord := range path {
+		if wrapped := grid.WrapCoord(coord); wrapped != coord {
+			t.Errorf("Path contains unwrapped coordinate %v", coord)
+		}
+	}
+}
+
+// bfsSteps returns the fewest steps between two hexes through passable
+// neighbors, or -1 when the goal is unreachable
+func bfsSteps(grid *Grid, from, to AxialCoord, passable func(AxialCoord) bool) int {
+	steps := map[AxialCoord]int{from: 0}
+	queue := []AxialCoord{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == to {
+			return steps[current]
+		}
+		for _, next := range grid.Neighbors(current) {
+			if _, seen := steps[next]; seen || !passable(next) {
+				continue
+			}
+			steps[next] = steps[current] + 1
+			queue = append(queue, next)
+		}
+	}
+	return -1
+}
+
+// TestFindPathSphericalMatchesBFS tests that spherical distances don't make
+// A* return longer paths than a breadth-first search
+func TestFindPathSphericalMatchesBFS(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, size := range [][2]int{{8, 40}, {40, 20}} {
+		grid := NewGrid(GridConfig{Width: size[0], Height: size[1], Topology: TopologyWorld, SphericalDistance: true})
+		coords := grid.AllCoords()
+
+		for trial := 0; trial < 100; trial++ {
+			blocked := make(map[AxialCoord]bool)
+			for _, coord := range coords {
+				blocked[coord] = rng.Float64() < 0.3
+			}
+			passable := func(c AxialCoord) bool { return !blocked[c] }
+
+			from := coords[rng.Intn(len(coords))]
+			to := coords[rng.Intn(len(coords))]
+			blocked[from], blocked[to] = false, false
+
+			want := bfsSteps(grid, from, to, passable)
+			path, found := grid.FindPath(from, to, passable)
+			if found != (want >= 0) {
+				t.Fatalf("%dx%d %v to %v: found=%v, BFS steps %d", size[0], size[1], from, to, found, want)
+			}
+			if found && len(path)-1 != want {
+				t.Errorf("%dx%d %v to %v: A* took %d steps, BFS %d",
+					size[0], size[1], from, to, len(path)-1, want)
+			}
+		}
+	}
+}
+
+// TestFindWeightedPathPrefersCheapRoute tests that a longer low-cost path
+// beats a shorter high-cost one
+func TestFindWeightedPathPrefersCheapRoute(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 10, Topology: TopologyRegion})
+	from := OffsetToAxial(1, 4)
+	to := OffsetToAxial(8, 4)
+
+	// Rows 3-5 between the endpoints are mountainous; everything else is cheap
+	cost := func(c AxialCoord) float64 {
+		col, row := c.ToOffset()
+		if row >= 3 && row <= 5 && col > 1 && col < 8 {
+			return 10
+		}
+		return 1
+	}
+
+	path, total, found, err := grid.FindWeightedPath(from, to, cost, 1)
+	if err != nil || !found {
+		t.Fatalf("Expected a weighted path, got error %v", err)
+	}
+
+	// Verify the reported cost matches the path
+	sum := 0.0
+	for _, coord := range path[1:] {
+		sum += cost(coord)
+	}
+	if sum != total {
+		t.Errorf("Reported cost %.1f does not match path cost %.1f", total, sum)
+	}
+
+	direct := from.DistanceTo(to, grid)
+	if len(path)-1 <= direct {
+		t.Errorf("Expected cheap detour longer than %d steps, got %d", direct, len(path)-1)
+	}
+
+	for _, coord := range path[1 : len(path)-1] {
+		if cost(coord) > 1 {
+			t.Errorf("Path crosses expensive hex %v", coord)
+		}
+	}
+}
+
+// TestFindWeightedPathImpassable tests that infinite cost hexes are never entered
+func TestFindWeightedPathImpassable(t *testing.T) {
+	grid, passable := wallGrid(-1)
+	cost := func(c AxialCoord) float64 {
+		if !passable(c) {
+			return math.Inf(1)
+		}
+		return 2
+	}
+
+	if _, _, found, err := grid.FindWeightedPath(OffsetToAxial(2, 2), OffsetToAxial(8, 2), cost, 2); found || err != nil {
+		t.Errorf("Expected no path through an infinite-cost wall, got found=%v, error %v", found, err)
+	}
+
+	path, total, found, err := grid.FindWeightedPath(OffsetToAxial(2, 2), OffsetToAxial(4, 2), cost, 2)
+	if err != nil || !found {
+		t.Fatalf("Expected a path on the open side of the wall, got error %v", err)
+	}
+	if total != float64(2*(len(path)-1)) {
+		t.Errorf("Expected total cost %d, got %.1f", 2*(len(path)-1), total)
+	}
+}
+
+// TestFindWeightedPathInvalidCost tests that costs which would break the
+// heuristic are reported instead of silently producing a worse path
+func TestFindWeightedPathInvalidCost(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 10, Topology: TopologyRegion})
+	from, to := OffsetToAxial(1, 1), OffsetToAxial(8, 8)
+	pit := OffsetToAxial(4, 4)
+
+	costs := map[string]float64{"zero": 0, "negative": -3, "NaN": math.NaN(), "below minimum": 0.5}
+	for name, bad := range costs {
+		cost := func(c AxialCoord) float64 {
+			if c == pit {
+				return bad
+			}
+			return 1
+		}
+		if _, _, found, err := grid.FindWeightedPath(from, to, cost, 1); err != ErrInvalidStepCost || found {
+			t.Errorf("%s cost: expected ErrInvalidStepCost, got found=%v, error %v", name, found, err)
+		}
+	}
+
+	open := func(AxialCoord) float64 { return 1 }
+	for _, minStepCost := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if _, _, _, err := grid.FindWeightedPath(from, to, open, minStepCost); err != ErrInvalidStepCost {
+			t.Errorf("Minimum step cost %v: expected ErrInvalidStepCost, got %v", minStepCost, err)
+		}
+	}
+}
+
+func BenchmarkFindPathLargeGrid(b *testing.B) {
+	grid := NewGrid(GridConfig{Width: 500, Height: 500, Topology: TopologyRegion})
+	from, to := OffsetToAxial(0, 0), OffsetToAxial(499, 499)
+
+	// A wall across most of the map forces the search to spread out
+	passable := func(c AxialCoord) bool {
+		col, row := c.ToOffset()
+		return col != 250 || row > 450
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, found := grid.FindPath(from, to, passable); !found {
+			b.Fatal("Expected a path around the wall")
+		}
+	}
+}
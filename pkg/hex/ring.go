@@ -0,0 +1,57 @@
+package hex
+
+// HexRing returns all hexes at exactly the given distance from this coordinate.
+// The ring is walked in order starting from the southwest corner, so consecutive
+// hexes are adjacent and the last hex connects back to the first.
+// For region topology, hexes outside the grid are omitted; for world topology,
+// hexes are wrapped into the grid
+func (c AxialCoord) HexRing(radius int, grid *Grid) []AxialCoord {
+	if radius < 0 {
+		return nil
+	}
+	if radius == 0 {
+		return []AxialCoord{c}
+	}
+
+	ring := make([]AxialCoord, 0, 6*radius)
+
+	// Start at the corner reached by walking 'radius' steps in direction 4
+	current := AxialCoord{
+		Q: c.Q + hexDirections[4].Q*radius,
+		R: c.R + hexDirections[4].R*radius,
+	}
+
+	for side := 0; side < 6; side++ {
+		for step := 0; step < radius; step++ {
+			if grid.config.Topology == TopologyWorld {
+				ring = append(ring, grid.WrapCoord(current))
+			} else if grid.IsValid(current) {
+				ring = append(ring, current)
+			}
+
+			current = AxialCoord{
+				Q: current.Q + hexDirections[side].Q,
+				R: current.R + hexDirections[side].R,
+			}
+		}
+	}
+
+	return ring
+}
+
+// Spiral returns the center followed by each ring out to radius, in order of
+// increasing distance. Each ring is walked as in HexRing, so hexes closer to the
+// center always come first. Grid handling matches HexRing; on small world grids
+// large radii revisit wrapped hexes
+func (c AxialCoord) Spiral(radius int, grid *Grid) []AxialCoord {
+	if radius < 0 {
+		return nil
+	}
+
+	spiral := make([]AxialCoord, 0, 1+3*radius*(radius+1))
+	for r := 0; r <= radius; r++ {
+		spiral = append(spiral, c.HexRing(r, grid)...)
+	}
+
+	return spiral
+}
@@ -0,0 +1,108 @@
+package hex
+
+import (
+	"testing"
+)
+
+// TestHexRing tests that rings contain 6*radius hexes at the exact distance
+func TestHexRing(t *testing.T) {
+	config := GridConfig{Width: 40, Height: 40, Topology: TopologyRegion}
+	grid := NewGrid(config)
+	center := OffsetToAxial(20, 20)
+
+	for radius := 0; radius <= 5; radius++ {
+		ring := center.HexRing(radius, grid)
+
+		expectedCount := 6 * radius
+		if radius == 0 {
+			expectedCount = 1
+		}
+		if len(ring) != expectedCount {
+			t.Errorf("radius %d: expected %d hexes, got %d", radius, expectedCount, len(ring))
+			continue
+		}
+
+		for _, coord := range ring {
+			if dist := center.DistanceTo(coord, grid); dist != radius {
+				t.Errorf("radius %d: hex %v at distance %d", radius, coord, dist)
+			}
+		}
+
+		if radius == 0 {
+			if ring[0] != center {
+				t.Errorf("radius 0: expected center %v, got %v", center, ring[0])
+			}
+			continue
+		}
+
+		// Consecutive hexes must be adjacent and the ring must close
+		for i := range ring {
+			next := ring[(i+1)%len(ring)]
+			if dist := ring[i].DistanceTo(next, grid); dist != 1 {
+				t.Errorf("radius %d: hexes %v and %v are not adjacent (distance %d)",
+					radius, ring[i], next, dist)
+			}
+		}
+	}
+}
+
+// TestHexRingRegionBoundary tests that rings are clipped to region grids
+func TestHexRingRegionBoundary(t *testing.T) {
+	config := GridConfig{Width: 5, Height: 5, Topology: TopologyRegion}
+	grid := NewGrid(config)
+	corner := NewAxialCoord(0, 0)
+
+	ring := corner.HexRing(1, grid)
+	if len(ring) != len(corner.Neighbors(grid)) {
+		t.Errorf("Expected ring of %d hexes at corner, got %d",
+			len(corner.Neighbors(grid)), len(ring))
+	}
+
+	for _, coord := range ring {
+		if !grid.IsValid(coord) {
+			t.Errorf("Ring contains invalid coordinate %v", coord)
+		}
+	}
+
+	if ring := corner.HexRing(-1, grid); len(ring) != 0 {
+		t.Errorf("Expected empty ring for negative radius, got %d hexes", len(ring))
+	}
+}
+
+// TestSpiral tests spiral ordering and size
+func TestSpiral(t *testing.T) {
+	config := GridConfig{Width: 30, Height: 30, Topology: TopologyRegion}
+	grid := NewGrid(config)
+	center := OffsetToAxial(15, 15)
+
+	for radius := 0; radius <= 5; radius++ {
+		spiral := center.Spiral(radius, grid)
+
+		expected := 1 + 3*radius*(radius+1)
+		if len(spiral) != expected {
+			t.Errorf("radius %d: expected %d hexes, got %d", radius, expected, len(spiral))
+		}
+		if spiral[0] != center {
+			t.Errorf("radius %d: expected spiral to begin at center, got %v", radius, spiral[0])
+		}
+
+		seen := make(map[AxialCoord]bool)
+		last := 0
+		for _, coord := range spiral {
+			if seen[coord] {
+				t.Errorf("radius %d: duplicate hex %v", radius, coord)
+			}
+			seen[coord] = true
+
+			dist := center.DistanceTo(coord, grid)
+			if dist < last {
+				t.Errorf("radius %d: %v at distance %d follows distance %d", radius, coord, dist, last)
+			}
+			last = dist
+		}
+	}
+
+	if spiral := center.Spiral(-1, grid); len(spiral) != 0 {
+		t.Errorf("Expected empty spiral for negative radius, got %d hexes", len(spiral))
+	}
+}
@@ -1,5 +1,11 @@
 package hex
 
+import (
+	"errors"
+	"math"
+	"sync"
+)
+
 // Topology defines how grid edges behave
 type Topology int
 
@@ -8,41 +14,188 @@ const (
 	TopologyWorld                  // Toroidal wrapping, all hexes have 6 neighbors
 )
 
+// Shape defines which hexes a grid contains
+type Shape int
+
+const (
+	ShapeRectangle Shape = iota // Width x Height hexes in offset layout
+	ShapeHexagon                // Hexes within (Width-1)/2 steps of the center
+	ShapeRhombus                // Width x Height hexes in axial coordinates (q, r >= 0)
+	ShapeTriangle               // Width hexes per side (q, r >= 0, q+r < Width)
+)
+
+var (
+	// ErrWorldRequiresRectangle is returned when a non-rectangular grid is asked to wrap
+	ErrWorldRequiresRectangle = errors.New("world topology requires rectangle shape")
+	// ErrUnknownShape is returned for shapes outside the defined set
+	ErrUnknownShape = errors.New("unknown grid shape")
+	// ErrUnknownTopology is returned for topologies outside the defined set
+	ErrUnknownTopology = errors.New("unknown grid topology")
+	// ErrUnknownOrientation is returned for orientations outside the defined set
+	ErrUnknownOrientation = errors.New("unknown grid orientation")
+	// ErrGridSize is returned when a dimension the shape uses is not positive
+	ErrGridSize = errors.New("grid dimensions must be positive")
+	// ErrSubgridBounds is returned when a subgrid window leaves the grid
+	ErrSubgridBounds = errors.New("subgrid window outside grid bounds")
+	// ErrSubgridOddColumn is returned when a subgrid starts on an odd column,
+	// which would shift the even-q column stagger and break adjacency
+	ErrSubgridOddColumn = errors.New("subgrid must start on an even column")
+)
+
 // Grid represents a hexagonal grid with configurable topology
 type Grid struct {
 	config   GridConfig
 	tiles    [][]interface{}
 	coordMap map[AxialCoord]bool
+	coords   []AxialCoord // Every valid coordinate in row-major offset order
+	minCol   int          // Offset of tiles[0][0], since shaped grids need not start at (0, 0)
+	minRow   int
+	
+	neighbors *neighborCache // Region neighbor lists, built on first use
+}
+
+// neighborCache holds the neighbor list of every hex in a region grid,
+// indexed by tile storage position
+type neighborCache struct {
+	once  sync.Once
+	lists [][]AxialCoord
 }
 
 // GridConfig defines the configuration for a hex grid
 type GridConfig struct {
 	Width, Height int
 	Topology      Topology
+	Orientation   Orientation // Pixel layout; storage always uses even-q offsets
+	Shape         Shape       // Which hexes the grid contains (world grids must be rectangles)
+	
+	// SphericalDistance makes world-topology DistanceTo treat rows as latitude
+	// and columns as longitude, measuring great-circle distance on a globe
+	SphericalDistance bool
+}
+
+// Validate checks that the configuration describes a buildable grid
+func (c GridConfig) Validate() error {
+	if c.Shape < ShapeRectangle || c.Shape > ShapeTriangle {
+		return ErrUnknownShape
+	}
+	if c.Topology != TopologyRegion && c.Topology != TopologyWorld {
+		return ErrUnknownTopology
+	}
+	if c.Orientation != FlatTop && c.Orientation != PointyTop {
+		return ErrUnknownOrientation
+	}
+	// Hexagons and triangles are sized by Width alone
+	if c.Width <= 0 || (c.Height <= 0 && (c.Shape == ShapeRectangle || c.Shape == ShapeRhombus)) {
+		return ErrGridSize
+	}
+	if c.Topology == TopologyWorld && c.Shape != ShapeRectangle {
+		return ErrWorldRequiresRectangle
+	}
+	return nil
 }
 
-// NewGrid creates a new hexagonal grid with the specified configuration
+// NewGrid creates a new hexagonal grid with the specified configuration.
+// It panics if the configuration fails Validate; use NewGridE to get the
+// error instead when the configuration comes from user input
 func NewGrid(config GridConfig) *Grid {
-	tiles := make([][]interface{}, config.Height)
-	for i := range tiles {
-		tiles[i] = make([]interface{}, config.Width)
+	grid, err := NewGridE(config)
+	if err != nil {
+		panic("NewGrid: " + err.Error())
+	}
+	return grid
+}
+
+// NewGridE creates a new hexagonal grid, returning the Validate error for an
+// invalid configuration instead of panicking
+func NewGridE(config GridConfig) (*Grid, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	coords := shapeCoords(config)
+	coordMap := make(map[AxialCoord]bool, len(coords))
+	
+	// Pre-populate coordinate map for faster lookups, tracking the offset
+	// bounding box that backs tile storage
+	minCol, minRow := 0, 0
+	maxCol, maxRow := -1, -1
+	for i, coord := range coords {
+		coordMap[coord] = true
+		
+		col, row := coord.ToOffset()
+		if i == 0 {
+			minCol, maxCol, minRow, maxRow = col, col, row, row
+			continue
+		}
+		minCol = min(minCol, col)
+		maxCol = max(maxCol, col)
+		minRow = min(minRow, row)
+		maxRow = max(maxRow, row)
 	}
 
-	coordMap := make(map[AxialCoord]bool)
+	tiles := make([][]interface{}, maxRow-minRow+1)
+	for i := range tiles {
+		tiles[i] = make([]interface{}, maxCol-minCol+1)
+	}
 	
-	// Pre-populate coordinate map for faster lookups
-	for row := 0; row < config.Height; row++ {
-		for col := 0; col < config.Width; col++ {
-			coord := OffsetToAxial(col, row)
-			coordMap[coord] = true
+	// The grid never changes shape, so list its coordinates once in storage order
+	ordered := make([]AxialCoord, 0, len(coordMap))
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			if coord := OffsetToAxial(col, row); coordMap[coord] {
+				ordered = append(ordered, coord)
+			}
 		}
 	}
 
 	return &Grid{
-		config:   config,
-		tiles:    tiles,
-		coordMap: coordMap,
+		config:    config,
+		tiles:     tiles,
+		coordMap:  coordMap,
+		coords:    ordered,
+		minCol:    minCol,
+		minRow:    minRow,
+		neighbors: &neighborCache{},
+	}, nil
+}
+
+// shapeCoords lists the coordinates a grid configuration contains
+func shapeCoords(config GridConfig) []AxialCoord {
+	var coords []AxialCoord
+	
+	switch config.Shape {
+	case ShapeHexagon:
+		if config.Width <= 0 {
+			return nil
+		}
+		radius := (config.Width - 1) / 2
+		center := OffsetToAxial(radius, radius)
+		for dq := -radius; dq <= radius; dq++ {
+			for dr := max(-radius, -dq-radius); dr <= min(radius, -dq+radius); dr++ {
+				coords = append(coords, NewAxialCoord(center.Q+dq, center.R+dr))
+			}
+		}
+	case ShapeRhombus:
+		for q := 0; q < config.Width; q++ {
+			for r := 0; r < config.Height; r++ {
+				coords = append(coords, NewAxialCoord(q, r))
+			}
+		}
+	case ShapeTriangle:
+		for q := 0; q < config.Width; q++ {
+			for r := 0; r < config.Width-q; r++ {
+				coords = append(coords, NewAxialCoord(q, r))
+			}
+		}
+	default:
+		for row := 0; row < config.Height; row++ {
+			for col := 0; col < config.Width; col++ {
+				coords = append(coords, OffsetToAxial(col, row))
+			}
+		}
 	}
+	
+	return coords
 }
 
 // Topology returns the topology type of this grid
@@ -50,16 +203,37 @@ func (g *Grid) Topology() Topology {
 	return g.config.Topology
 }
 
+// Config returns the configuration this grid was created with
+func (g *Grid) Config() GridConfig {
+	return g.config
+}
+
+// ToPixel converts a coordinate to pixel space using the grid's orientation
+func (g *Grid) ToPixel(coord AxialCoord, hexSize float64) (x, y float64) {
+	return coord.ToPixelOriented(hexSize, g.config.Orientation)
+}
+
+// PixelToAxial converts a pixel position to a coordinate using the grid's orientation
+func (g *Grid) PixelToAxial(x, y, hexSize float64) AxialCoord {
+	return PixelToAxialOriented(x, y, hexSize, g.config.Orientation)
+}
+
+// HexAtPixel returns the grid hex under a pixel position, for picking hexes
+// from screen clicks. World grids wrap the result onto the stored map; ok is
+// false when the pixel falls outside every hex of a region grid
+func (g *Grid) HexAtPixel(x, y, hexSize float64) (coord AxialCoord, ok bool) {
+	coord = g.WrapCoord(g.PixelToAxial(x, y, hexSize))
+	if !g.IsValid(coord) {
+		return AxialCoord{}, false
+	}
+	return coord, true
+}
+
 // IsValid checks if a coordinate is valid within this grid
 func (g *Grid) IsValid(coord AxialCoord) bool {
-	if g.config.Topology == TopologyWorld {
-		// In world topology, check if the wrapped coordinate is in the grid
-		wrapped := g.WrapCoord(coord)
-		return g.coordMap[wrapped]
-	}
-	
-	// For region topology, check if coordinate is in our map
-	return g.coordMap[coord]
+	// In world topology the wrapped coordinate is checked
+	_, _, ok := g.index(coord)
+	return ok
 }
 
 // WrapCoord wraps a coordinate for world topology
@@ -81,46 +255,136 @@ func (g *Grid) WrapCoord(coord AxialCoord) AxialCoord {
 
 // Get retrieves a value from the grid at the specified coordinate
 func (g *Grid) Get(coord AxialCoord) interface{} {
-	if g.config.Topology == TopologyWorld {
-		coord = g.WrapCoord(coord)
-	}
-	
-	if !g.IsValid(coord) {
+	row, col, ok := g.index(coord)
+	if !ok {
 		return nil
 	}
-	
-	col, row := coord.ToOffset()
 	return g.tiles[row][col]
 }
 
 // Set stores a value in the grid at the specified coordinate
 func (g *Grid) Set(coord AxialCoord, value interface{}) {
+	if row, col, ok := g.index(coord); ok {
+		g.tiles[row][col] = value
+	}
+}
+
+// index returns the storage position of a coordinate, wrapping it first on
+// world grids. ok is false for coordinates outside the grid
+func (g *Grid) index(coord AxialCoord) (row, col int, ok bool) {
 	if g.config.Topology == TopologyWorld {
 		coord = g.WrapCoord(coord)
 	}
 	
-	if !g.IsValid(coord) {
-		return
+	c, r := coord.ToOffset()
+	row, col = r-g.minRow, c-g.minCol
+	if row < 0 || row >= len(g.tiles) || col < 0 || col >= len(g.tiles[row]) {
+		return 0, 0, false
 	}
 	
-	col, row := coord.ToOffset()
-	g.tiles[row][col] = value
+	// Rectangles fill their storage; other shapes leave gaps
+	if g.config.Shape != ShapeRectangle && !g.coordMap[coord] {
+		return 0, 0, false
+	}
+	return row, col, true
 }
 
-// AllCoords returns all valid coordinates in the grid
-func (g *Grid) AllCoords() []AxialCoord {
-	coords := make([]AxialCoord, 0, g.config.Width*g.config.Height)
+// cellIndex returns a coordinate's position in row-major tile storage, for
+// indexing per-hex scratch slices of length cellCount
+func (g *Grid) cellIndex(coord AxialCoord) (int, bool) {
+	row, col, ok := g.index(coord)
+	if !ok {
+		return 0, false
+	}
+	return row*len(g.tiles[0]) + col, true
+}
+
+// cellCount returns the number of storage cells, including gaps in shaped grids
+func (g *Grid) cellCount() int {
+	if len(g.tiles) == 0 {
+		return 0
+	}
+	return len(g.tiles) * len(g.tiles[0])
+}
+
+// Subgrid returns a new region grid covering a width x height window of this
+// grid starting at offset (minCol, minRow). Coordinates in the subgrid are
+// re-based so the window's first hex is offset (0, 0). minCol must be even so
+// the even-q stagger, and therefore adjacency, is preserved
+func (g *Grid) Subgrid(minCol, minRow, width, height int) (*Grid, error) {
+	if width <= 0 || height <= 0 {
+		return nil, ErrSubgridBounds
+	}
+	if minCol&1 != 0 {
+		return nil, ErrSubgridOddColumn
+	}
+
+	for row := minRow; row < minRow+height; row++ {
+		for col := minCol; col < minCol+width; col++ {
+			if !g.coordMap[OffsetToAxial(col, row)] {
+				return nil, ErrSubgridBounds
+			}
+		}
+	}
+
+	return NewGrid(GridConfig{
+		Width:       width,
+		Height:      height,
+		Topology:    TopologyRegion,
+		Orientation: g.config.Orientation,
+	}), nil
+}
+
+// Bounds returns the offset bounding box of the grid's hexes, inclusive. An
+// empty grid reports maxCol < minCol and maxRow < minRow
+func (g *Grid) Bounds() (minCol, minRow, maxCol, maxRow int) {
+	if len(g.tiles) == 0 {
+		return 0, 0, -1, -1
+	}
+	return g.minCol, g.minRow, g.minCol + len(g.tiles[0]) - 1, g.minRow + len(g.tiles) - 1
+}
+
+// PixelBounds returns the pixel extent of every hex in the grid, vertices
+// included, using the grid's orientation. An image of size maxX-minX by
+// maxY-minY, translated by (-minX, -minY), fits the whole map
+func (g *Grid) PixelBounds(hexSize float64) (minX, minY, maxX, maxY float64) {
+	if len(g.coords) == 0 {
+		return 0, 0, 0, 0
+	}
 	
-	for row := 0; row < g.config.Height; row++ {
-		for col := 0; col < g.config.Width; col++ {
-			coord := OffsetToAxial(col, row)
-			coords = append(coords, coord)
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, coord := range g.coords {
+		cx, cy := g.ToPixel(coord, hexSize)
+		for i := 0; i < 6; i++ {
+			dx, dy := CornerOffset(g.config.Orientation, hexSize, i)
+			minX = math.Min(minX, cx+dx)
+			minY = math.Min(minY, cy+dy)
+			maxX = math.Max(maxX, cx+dx)
+			maxY = math.Max(maxY, cy+dy)
 		}
 	}
 	
+	return minX, minY, maxX, maxY
+}
+
+// AllCoords returns all valid coordinates in the grid in row-major offset order.
+// The coordinates are computed once when the grid is built; each call returns
+// a fresh copy, so callers may modify it freely
+func (g *Grid) AllCoords() []AxialCoord {
+	coords := make([]AxialCoord, len(g.coords))
+	copy(coords, g.coords)
 	return coords
 }
 
+// ForEachCoord calls fn for every valid coordinate in the same order as
+// AllCoords, without allocating a slice
+func (g *Grid) ForEachCoord(fn func(AxialCoord)) {
+	for _, coord := range g.coords {
+		fn(coord)
+	}
+}
+
 // hexDirections are the 6 directions from any hex to its neighbors
 var hexDirections = [6]AxialCoord{
 	{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1},
@@ -128,23 +392,68 @@ var hexDirections = [6]AxialCoord{
 
 // Neighbors returns all valid neighbors of a coordinate based on grid topology
 func (c AxialCoord) Neighbors(grid *Grid) []AxialCoord {
-	neighbors := make([]AxialCoord, 0, 6)
+	if cached, ok := grid.cachedNeighbors(c); ok {
+		return append(make([]AxialCoord, 0, len(cached)), cached...)
+	}
+	return grid.appendNeighbors(make([]AxialCoord, 0, 6), c)
+}
+
+// Neighbors returns all valid neighbors of a coordinate, like
+// AxialCoord.Neighbors. On region grids the lists for every hex are computed
+// once and shared between calls, so the result must not be modified
+func (g *Grid) Neighbors(c AxialCoord) []AxialCoord {
+	if cached, ok := g.cachedNeighbors(c); ok {
+		return cached
+	}
+	return g.appendNeighbors(make([]AxialCoord, 0, 6), c)
+}
+
+// cachedNeighbors returns the shared neighbor list of a hex in a region grid.
+// ok is false for world grids and coordinates outside the grid
+func (g *Grid) cachedNeighbors(c AxialCoord) ([]AxialCoord, bool) {
+	if g.config.Topology != TopologyRegion {
+		return nil, false
+	}
+	i, ok := g.cellIndex(c)
+	if !ok {
+		return nil, false
+	}
+	
+	g.neighbors.once.Do(g.buildNeighborCache)
+	return g.neighbors.lists[i], true
+}
+
+// buildNeighborCache computes every hex's neighbor list into one backing array
+func (g *Grid) buildNeighborCache() {
+	lists := make([][]AxialCoord, g.cellCount())
+	backing := make([]AxialCoord, 0, 6*len(g.coords))
+	
+	for _, coord := range g.coords {
+		i, _ := g.cellIndex(coord)
+		start := len(backing)
+		backing = g.appendNeighbors(backing, coord)
+		
+		// Cap each list so appending to it can't overwrite the next one
+		lists[i] = backing[start:len(backing):len(backing)]
+	}
 	
+	g.neighbors.lists = lists
+}
+
+// appendNeighbors appends the valid neighbors of c to neighbors
+func (g *Grid) appendNeighbors(neighbors []AxialCoord, c AxialCoord) []AxialCoord {
 	for _, direction := range hexDirections {
 		neighbor := AxialCoord{
 			Q: c.Q + direction.Q,
 			R: c.R + direction.R,
 		}
 		
-		if grid.config.Topology == TopologyWorld {
+		if g.config.Topology == TopologyWorld {
 			// In world topology, all neighbors are valid (after wrapping)
-			wrapped := grid.WrapCoord(neighbor)
-			neighbors = append(neighbors, wrapped)
-		} else {
+			neighbors = append(neighbors, g.WrapCoord(neighbor))
+		} else if g.IsValid(neighbor) {
 			// In region topology, only add if the neighbor is valid
-			if grid.IsValid(neighbor) {
-				neighbors = append(neighbors, neighbor)
-			}
+			neighbors = append(neighbors, neighbor)
 		}
 	}
 	
@@ -159,8 +468,7 @@ func (c AxialCoord) IsEdgeHex(grid *Grid) bool {
 	}
 	
 	// A hex is an edge hex if it has fewer than 6 neighbors
-	neighbors := c.Neighbors(grid)
-	return len(neighbors) < 6
+	return len(grid.Neighbors(c)) < 6
 }
 
 // DistanceTo calculates the distance between two coordinates
@@ -171,24 +479,76 @@ func (c AxialCoord) DistanceTo(other AxialCoord, grid *Grid) int {
 		return hexDistance(c, other)
 	}
 	
-	// For world topology, consider wrapped distances
-	minDist := hexDistance(c, other)
+	if grid.config.SphericalDistance {
+		return sphericalDistance(c, other, grid)
+	}
 	
-	// Try all possible wrapped versions of 'other'
-	for dq := -1; dq <= 1; dq++ {
-		for dr := -1; dr <= 1; dr++ {
-			wrappedOther := AxialCoord{
-				Q: other.Q + dq*grid.config.Width,
-				R: other.R + dr*grid.config.Height,
-			}
-			dist := hexDistance(c, wrappedOther)
+	return grid.stepDistance(c, other)
+}
+
+// stepDistance returns the number of hex steps between two coordinates,
+// measuring to the nearest wrapped copy on world grids. Unlike DistanceTo it
+// ignores SphericalDistance, so it is exact for neighbor-by-neighbor movement
+func (g *Grid) stepDistance(a, b AxialCoord) int {
+	if g.config.Topology == TopologyRegion {
+		return hexDistance(a, b)
+	}
+	return hexDistance(a, g.nearestWrap(a, b))
+}
+
+// sphericalDistance approximates hex distance on a globe. Row centers are
+// spread evenly from pole to pole and columns evenly around the equator; the
+// great-circle angle between the two hexes is counted in rows, so east-west
+// steps shrink toward the poles. Steps match hex distance at the equator when
+// the grid is twice as wide as it is tall. Distinct hexes are at least 1 apart
+func sphericalDistance(a, b AxialCoord, grid *Grid) int {
+	a, b = grid.WrapCoord(a), grid.WrapCoord(b)
+	if a == b {
+		return 0
+	}
+	
+	width, height := float64(grid.config.Width), float64(grid.config.Height)
+	toLatLon := func(coord AxialCoord) (lat, lon float64) {
+		col, row := coord.ToOffset()
+		lat = math.Pi/2 - math.Pi*(float64(row)+0.5)/height
+		lon = 2 * math.Pi * float64(col) / width
+		return lat, lon
+	}
+	
+	latA, lonA := toLatLon(a)
+	latB, lonB := toLatLon(b)
+	
+	// Haversine formula for the central angle
+	sinLat := math.Sin((latB - latA) / 2)
+	sinLon := math.Sin((lonB - lonA) / 2)
+	h := sinLat*sinLat + math.Cos(latA)*math.Cos(latB)*sinLon*sinLon
+	angle := 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+	
+	rowAngle := math.Pi / height
+	return max(1, int(math.Round(angle/rowAngle)))
+}
+
+// nearestWrap returns the copy of 'to', shifted by whole grid periods in offset
+// space, that lies closest to 'from'. Wrapping is defined on offset
+// coordinates, so shifting axial Q and R directly would land off the lattice
+func (g *Grid) nearestWrap(from, to AxialCoord) AxialCoord {
+	best := to
+	minDist := hexDistance(from, to)
+	
+	toCol, toRow := to.ToOffset()
+	for dCol := -1; dCol <= 1; dCol++ {
+		for dRow := -1; dRow <= 1; dRow++ {
+			wrappedTo := OffsetToAxial(toCol+dCol*g.config.Width, toRow+dRow*g.config.Height)
+			
+			dist := hexDistance(from, wrappedTo)
 			if dist < minDist {
 				minDist = dist
+				best = wrappedTo
 			}
 		}
 	}
 	
-	return minDist
+	return best
 }
 
 // hexDistance calculates the standard hex distance between two coordinates
@@ -211,26 +571,8 @@ func (g *Grid) ShortestPath(from, to AxialCoord) []AxialCoord {
 		return hexPathRegion(from, to)
 	}
 	
-	// For world topology, find the wrapped version of 'to' that gives shortest distance
-	bestTo := to
-	minDist := hexDistance(from, to)
-	
-	// Try all possible wrapped versions of 'to' - need to check more offsets
-	for dCol := -1; dCol <= 1; dCol++ {
-		for dRow := -1; dRow <= 1; dRow++ {
-			// Create wrapped target in offset space then convert to axial
-			toCol, toRow := to.ToOffset()
-			wrappedCol := toCol + dCol*g.config.Width
-			wrappedRow := toRow + dRow*g.config.Height
-			wrappedTo := OffsetToAxial(wrappedCol, wrappedRow)
-			
-			dist := hexDistance(from, wrappedTo)
-			if dist < minDist {
-				minDist = dist
-				bestTo = wrappedTo
-			}
-		}
-	}
+	// For world topology, head for the wrapped version of 'to' that gives shortest distance
+	bestTo := g.nearestWrap(from, to)
 	
 	// Generate path to best target, then wrap coordinates back to valid range
 	path := hexPathRegion(from, bestTo)
@@ -145,15 +145,27 @@ func TestDistanceCalculation(t *testing.T) {
 		},
 		{
 			NewAxialCoord(0, 0), NewAxialCoord(9, 0),
-			9, 1, "horizontal wrapping beneficial in world topology",
+			9, 4, "horizontal wrapping beneficial in world topology",
 		},
 		{
 			NewAxialCoord(1, 0), NewAxialCoord(1, 7),
 			7, 1, "vertical wrapping beneficial in world topology", 
 		},
 		{
-			NewAxialCoord(0, 0), NewAxialCoord(9, 7),
-			16, 2, "both wrappings beneficial in world topology",
+			NewAxialCoord(0, 0), OffsetToAxial(9, 7),
+			11, 2, "both wrappings beneficial in world topology (bottom-right corner)",
+		},
+		{
+			NewAxialCoord(0, 0), NewAxialCoord(9, -5),
+			9, 1, "top-right corner wraps to an adjacent hex",
+		},
+		{
+			NewAxialCoord(0, 0), NewAxialCoord(8, -4),
+			8, 2, "top edge wraps through offset space, not axial space",
+		},
+		{
+			NewAxialCoord(0, 0), NewAxialCoord(6, 0),
+			6, 5, "axial-space wrap vector must not shortcut off the lattice",
 		},
 	}
 
@@ -173,6 +185,34 @@ func TestDistanceCalculation(t *testing.T) {
 	}
 }
 
+// TestWorldDistanceMatchesBreadthFirst checks world distances against a
+// breadth-first walk over wrapped neighbors
+func TestWorldDistanceMatchesBreadthFirst(t *testing.T) {
+	grid := NewGrid(GridConfig{Width: 10, Height: 8, Topology: TopologyWorld})
+	coords := grid.AllCoords()
+
+	for _, from := range coords {
+		steps := map[AxialCoord]int{from: 0}
+		queue := []AxialCoord{from}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range current.Neighbors(grid) {
+				if _, seen := steps[neighbor]; !seen {
+					steps[neighbor] = steps[current] + 1
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+
+		for _, to := range coords {
+			if got := from.DistanceTo(to, grid); got != steps[to] {
+				t.Errorf("Distance %v→%v = %d, expected %d", from, to, got, steps[to])
+			}
+		}
+	}
+}
+
 // TestDistanceSymmetry tests that distance calculations are symmetric
 func TestDistanceSymmetry(t *testing.T) {
 	configs := []GridConfig{
@@ -242,4 +282,43 @@ func TestShortestPath(t *testing.T) {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestSphericalDistance tests that spherical world distances shrink toward the poles
+func TestSphericalDistance(t *testing.T) {
+	config := GridConfig{Width: 20, Height: 10, Topology: TopologyWorld, SphericalDistance: true}
+	grid := NewGrid(config)
+
+	equatorial := OffsetToAxial(0, 5).DistanceTo(OffsetToAxial(6, 5), grid)
+	polar := OffsetToAxial(0, 0).DistanceTo(OffsetToAxial(6, 0), grid)
+	if equatorial <= polar {
+		t.Errorf("Equatorial east-west distance %d should exceed polar distance %d", equatorial, polar)
+	}
+
+	// At the equator, east-west steps match the column delta
+	if equatorial != 6 {
+		t.Errorf("Expected equatorial distance 6, got %d", equatorial)
+	}
+
+	// Longitude wraps around the globe
+	if d := OffsetToAxial(0, 5).DistanceTo(OffsetToAxial(19, 5), grid); d != 1 {
+		t.Errorf("Expected wrapped distance 1, got %d", d)
+	}
+
+	origin := OffsetToAxial(3, 4)
+	if d := origin.DistanceTo(origin, grid); d != 0 {
+		t.Errorf("Expected zero distance to self, got %d", d)
+	}
+	for _, neighbor := range origin.Neighbors(grid) {
+		if d := origin.DistanceTo(neighbor, grid); d < 1 {
+			t.Errorf("Distinct hexes %v and %v should be at least 1 apart, got %d", origin, neighbor, d)
+		}
+	}
+
+	// The option is ignored for region grids
+	config.Topology = TopologyRegion
+	region := NewGrid(config)
+	if d := OffsetToAxial(0, 0).DistanceTo(OffsetToAxial(6, 0), region); d != hexDistance(OffsetToAxial(0, 0), OffsetToAxial(6, 0)) {
+		t.Errorf("Region grids should use plain hex distance, got %d", d)
+	}
+}
@@ -0,0 +1,45 @@
+package hex
+
+// RotateAround rotates the coordinate by steps * 60° about center using cube
+// coordinate rotation. Positive steps rotate in the order of hexDirections
+// (counter-clockwise in axial space); negative steps rotate the other way and
+// any multiple of 6 is the identity
+func (c AxialCoord) RotateAround(center AxialCoord, steps int) AxialCoord {
+	// Work relative to the center in cube coordinates (q, r, s)
+	q := c.Q - center.Q
+	r := c.R - center.R
+	s := -q - r
+
+	steps = ((steps % 6) + 6) % 6
+	for i := 0; i < steps; i++ {
+		// One 60° step: (q, r, s) -> (-s, -q, -r)
+		q, r, s = -s, -q, -r
+	}
+
+	return AxialCoord{Q: center.Q + q, R: center.R + r}
+}
+
+// ReflectAxis selects the cube axis a reflection keeps fixed
+type ReflectAxis int
+
+const (
+	ReflectQ ReflectAxis = iota // Keep q, swap r and s
+	ReflectR                    // Keep r, swap q and s
+	ReflectS                    // Keep s, swap q and r
+)
+
+// Reflect mirrors the coordinate across a cube axis through the origin.
+// Reflecting twice across the same axis is the identity
+func (c AxialCoord) Reflect(axis ReflectAxis) AxialCoord {
+	q, r := c.Q, c.R
+	s := -q - r
+
+	switch axis {
+	case ReflectQ:
+		return AxialCoord{Q: q, R: s}
+	case ReflectR:
+		return AxialCoord{Q: s, R: r}
+	default:
+		return AxialCoord{Q: r, R: q}
+	}
+}
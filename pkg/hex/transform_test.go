@@ -0,0 +1,72 @@
+package hex
+
+import "testing"
+
+// TestRotateAround tests rotation about a center hex
+func TestRotateAround(t *testing.T) {
+	center := NewAxialCoord(10, 5)
+	coord := NewAxialCoord(13, 4)
+	distance := hexDistance(center, coord)
+
+	seen := make(map[AxialCoord]bool)
+	for steps := 0; steps < 6; steps++ {
+		rotated := coord.RotateAround(center, steps)
+		if d := hexDistance(center, rotated); d != distance {
+			t.Errorf("steps %d: distance %d, expected %d", steps, d, distance)
+		}
+		if seen[rotated] {
+			t.Errorf("steps %d: rotation %v repeats an earlier step", steps, rotated)
+		}
+		seen[rotated] = true
+
+		// Negative steps undo positive ones
+		if back := rotated.RotateAround(center, -steps); back != coord {
+			t.Errorf("steps %d: rotating back gave %v, expected %v", steps, back, coord)
+		}
+	}
+
+	if rotated := coord.RotateAround(center, 6); rotated != coord {
+		t.Errorf("Expected 6 steps to be identity, got %v", rotated)
+	}
+	if rotated := center.RotateAround(center, 3); rotated != center {
+		t.Errorf("Expected center to be fixed, got %v", rotated)
+	}
+
+	// A single step moves each neighbor direction to the next one
+	origin := NewAxialCoord(0, 0)
+	for i, direction := range hexDirections {
+		if rotated := direction.RotateAround(origin, 1); rotated != hexDirections[(i+1)%6] {
+			t.Errorf("Direction %d rotated to %v, expected %v", i, rotated, hexDirections[(i+1)%6])
+		}
+	}
+}
+
+// TestReflect tests reflection across each cube axis
+func TestReflect(t *testing.T) {
+	origin := NewAxialCoord(0, 0)
+	coords := []AxialCoord{{0, 0}, {3, -1}, {-2, 5}, {4, 0}, {0, -3}, {-1, -1}}
+
+	for _, axis := range []ReflectAxis{ReflectQ, ReflectR, ReflectS} {
+		for _, coord := range coords {
+			reflected := coord.Reflect(axis)
+			if hexDistance(origin, reflected) != hexDistance(origin, coord) {
+				t.Errorf("axis %d: %v reflected to %v changes distance from origin", axis, coord, reflected)
+			}
+			if twice := reflected.Reflect(axis); twice != coord {
+				t.Errorf("axis %d: double reflection of %v gave %v", axis, coord, twice)
+			}
+		}
+	}
+
+	// Each reflection keeps its own axis fixed
+	coord := NewAxialCoord(2, -5)
+	if got := coord.Reflect(ReflectQ); got.Q != coord.Q {
+		t.Errorf("ReflectQ changed q: %v", got)
+	}
+	if got := coord.Reflect(ReflectR); got.R != coord.R {
+		t.Errorf("ReflectR changed r: %v", got)
+	}
+	if got := coord.Reflect(ReflectS); -got.Q-got.R != -coord.Q-coord.R {
+		t.Errorf("ReflectS changed s: %v", got)
+	}
+}
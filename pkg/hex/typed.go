@@ -0,0 +1,53 @@
+package hex
+
+// TypedGrid stores values of type T on a hex grid, giving compile-time typed
+// Get and Set. Topology, shape and coordinate queries come from the
+// underlying Grid returned by Grid()
+type TypedGrid[T any] struct {
+	grid   *Grid
+	values [][]T
+}
+
+// NewTypedGrid creates an empty typed grid with the given configuration.
+// Like NewGrid, it panics if the configuration fails Validate
+func NewTypedGrid[T any](config GridConfig) *TypedGrid[T] {
+	grid := NewGrid(config)
+
+	values := make([][]T, len(grid.tiles))
+	for i := range values {
+		values[i] = make([]T, len(grid.tiles[i]))
+	}
+
+	return &TypedGrid[T]{grid: grid, values: values}
+}
+
+// Grid returns the underlying grid for topology and coordinate queries.
+// Values stored with the typed grid are not visible through its Get
+func (g *TypedGrid[T]) Grid() *Grid {
+	return g.grid
+}
+
+// Get retrieves the value at the specified coordinate, or the zero value of T
+// for unset or out-of-grid coordinates
+func (g *TypedGrid[T]) Get(coord AxialCoord) T {
+	value, _ := g.Lookup(coord)
+	return value
+}
+
+// Lookup retrieves the value at the specified coordinate. ok is false for
+// coordinates outside the grid
+func (g *TypedGrid[T]) Lookup(coord AxialCoord) (value T, ok bool) {
+	row, col, ok := g.grid.index(coord)
+	if !ok {
+		return value, false
+	}
+	return g.values[row][col], true
+}
+
+// Set stores a value at the specified coordinate. Coordinates outside the
+// grid are ignored
+func (g *TypedGrid[T]) Set(coord AxialCoord, value T) {
+	if row, col, ok := g.grid.index(coord); ok {
+		g.values[row][col] = value
+	}
+}
@@ -0,0 +1,58 @@
+package hex
+
+import "testing"
+
+func TestTypedGrid(t *testing.T) {
+	type cell struct {
+		elevation float64
+		q         int
+	}
+
+	grid := NewTypedGrid[*cell](GridConfig{Width: 6, Height: 4, Topology: TopologyRegion})
+
+	coords := grid.Grid().AllCoords()
+	for i, coord := range coords {
+		grid.Set(coord, &cell{elevation: float64(i), q: coord.Q})
+	}
+	for i, coord := range coords {
+		got := grid.Get(coord)
+		if got == nil || got.elevation != float64(i) || got.q != coord.Q {
+			t.Errorf("Get(%v) = %+v, expected elevation %d", coord, got, i)
+		}
+	}
+
+	// Coordinates outside the grid read as the zero value and ignore writes
+	outside := NewAxialCoord(-3, -3)
+	grid.Set(outside, &cell{})
+	if got, ok := grid.Lookup(outside); ok || got != nil {
+		t.Errorf("Lookup(%v) = %v, %v; expected nil, false", outside, got, ok)
+	}
+}
+
+func TestTypedGridWorldWrap(t *testing.T) {
+	grid := NewTypedGrid[int](GridConfig{Width: 5, Height: 3, Topology: TopologyWorld})
+
+	// Offset column -1 wraps to column 4
+	grid.Set(OffsetToAxial(-1, 1), 7)
+	if got := grid.Get(OffsetToAxial(4, 1)); got != 7 {
+		t.Errorf("Expected wrapped write to be visible at offset (4,1), got %d", got)
+	}
+
+	// Unset cells read as zero but are still inside the grid
+	if got, ok := grid.Lookup(NewAxialCoord(0, 0)); !ok || got != 0 {
+		t.Errorf("Lookup((0,0)) = %d, %v; expected 0, true", got, ok)
+	}
+}
+
+func TestTypedGridShapes(t *testing.T) {
+	grid := NewTypedGrid[AxialCoord](GridConfig{Width: 7, Shape: ShapeHexagon})
+
+	for _, coord := range grid.Grid().AllCoords() {
+		grid.Set(coord, coord)
+	}
+	for _, coord := range grid.Grid().AllCoords() {
+		if got := grid.Get(coord); got != coord {
+			t.Errorf("Get(%v) = %v", coord, got)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package terrain
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// GenerateBatch generates terrain for each seed on the same grid, running up to
+// GOMAXPROCS generations at once, and returns the tiles keyed by seed. Each
+// result is identical to GenerateTerrain with config.Seed set to that seed
+// and config.Rand ignored.
+// Seeds that fail to generate, for example because config is invalid, are
+// left out of the result
+func GenerateBatch(grid *hex.Grid, config TerrainConfig, seeds []int64) map[int64][]*HexTile {
+	return generateBatch(grid, config, seeds, runtime.GOMAXPROCS(0))
+}
+
+// generateBatch runs GenerateBatch with a fixed number of workers
+func generateBatch(grid *hex.Grid, config TerrainConfig, seeds []int64, workers int) map[int64][]*HexTile {
+	results := make(map[int64][]*HexTile, len(seeds))
+	jobs := make(chan int64)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < max(1, min(workers, len(seeds))); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seed := range jobs {
+				seedConfig := config
+				seedConfig.Seed = seed
+				seedConfig.Rand = nil // Batch seeds replace any shared source
+
+				tiles, err := GenerateTerrain(grid, seedConfig)
+				if err != nil {
+					continue
+				}
+
+				mu.Lock()
+				results[seed] = tiles
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// Generate each distinct seed once
+	queued := make(map[int64]bool, len(seeds))
+	for _, seed := range seeds {
+		if !queued[seed] {
+			queued[seed] = true
+			jobs <- seed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,56 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestGenerateBatchMatchesIndividual(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 24, Height: 16})
+	config := DefaultTerrainConfig()
+	seeds := []int64{1, 2, 3, 42, 2, 1000}
+
+	// Force several workers even on a single CPU
+	results := generateBatch(grid, config, seeds, 4)
+
+	if len(results) != 5 {
+		t.Errorf("Expected 5 distinct seeds, got %d", len(results))
+	}
+
+	for _, seed := range seeds {
+		seedConfig := config
+		seedConfig.Seed = seed
+		expected, err := GenerateTerrain(grid, seedConfig)
+		if err != nil {
+			t.Fatalf("GenerateTerrain failed for seed %d: %v", seed, err)
+		}
+
+		tiles, ok := results[seed]
+		if !ok {
+			t.Fatalf("Missing result for seed %d", seed)
+		}
+		if len(tiles) != len(expected) {
+			t.Fatalf("Seed %d: expected %d tiles, got %d", seed, len(expected), len(tiles))
+		}
+		for i := range tiles {
+			if *tiles[i] != *expected[i] {
+				t.Errorf("Seed %d tile %d: got %+v, want %+v", seed, i, *tiles[i], *expected[i])
+				break
+			}
+		}
+	}
+}
+
+func TestGenerateBatchInvalidConfig(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 4, Height: 4})
+	config := DefaultTerrainConfig()
+	config.NoiseParams.Octaves = 0
+
+	if results := GenerateBatch(grid, config, []int64{1, 2}); len(results) != 0 {
+		t.Errorf("Expected no results for invalid config, got %d", len(results))
+	}
+	if results := GenerateBatch(grid, DefaultTerrainConfig(), nil); len(results) != 0 {
+		t.Errorf("Expected no results for no seeds, got %d", len(results))
+	}
+}
@@ -0,0 +1,197 @@
+package terrain
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// binaryMagic identifies a binary terrain file
+var binaryMagic = [4]byte{'H', 'E', 'X', 'T'}
+
+// BinaryVersion is the binary terrain format version written by WriteBinary
+const BinaryVersion uint16 = 1
+
+// tileFlagLand marks a land tile in a binary tile record
+const tileFlagLand = 1 << 0
+
+// binaryHeader is the fixed-size little-endian header following the magic number
+type binaryHeader struct {
+	Seed            int64
+	SeaLevel        float64
+	LandRatio       float64
+	NoiseType       int32
+	Octaves         int32
+	Persistence     float64
+	Lacunarity      float64
+	Scale           float64
+	HurstExp        float64
+	NoiseFlags      uint8
+	Falloff         uint8
+	FalloffStrength float64
+	Width           int32
+	Height          int32
+	Topology        uint8
+	Shape           uint8
+	GridFlags       uint8
+	TileCount       uint32
+}
+
+// binaryTile is a single 13-byte tile record
+type binaryTile struct {
+	Q         int32
+	R         int32
+	Elevation float32
+	Flags     uint8
+}
+
+// WriteBinary writes terrain in a compact little-endian format: a magic
+// number, format version and header, then one record per tile holding the
+// coordinate as two int32, the elevation as float32 and a flags byte.
+// Elevations are stored at float32 precision and DistanceToWater is not
+// stored; recompute it with ComputeDistanceToWater after reading
+func WriteBinary(w io.Writer, header TerrainStreamHeader, tiles []*HexTile) error {
+	buffered := bufio.NewWriter(w)
+
+	if _, err := buffered.Write(binaryMagic[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(buffered, binary.LittleEndian, BinaryVersion); err != nil {
+		return err
+	}
+
+	config := header.Config
+	var noiseFlags uint8
+	if config.NoiseParams.Parallel {
+		noiseFlags |= 1 << 0
+	}
+	if config.NoiseParams.Tileable {
+		noiseFlags |= 1 << 1
+	}
+
+	var gridFlags uint8
+	if header.Grid.Orientation == hex.PointyTop {
+		gridFlags |= 1 << 0
+	}
+	if header.Grid.SphericalDistance {
+		gridFlags |= 1 << 1
+	}
+
+	fixed := binaryHeader{
+		Seed:            config.Seed,
+		SeaLevel:        config.SeaLevel,
+		LandRatio:       config.LandRatio,
+		NoiseType:       int32(config.NoiseParams.Type),
+		Octaves:         int32(config.NoiseParams.Octaves),
+		Persistence:     config.NoiseParams.Persistence,
+		Lacunarity:      config.NoiseParams.Lacunarity,
+		Scale:           config.NoiseParams.Scale,
+		HurstExp:        config.NoiseParams.HurstExp,
+		NoiseFlags:      noiseFlags,
+		Falloff:         uint8(config.Falloff),
+		FalloffStrength: config.FalloffStrength,
+		Width:           int32(header.Grid.Width),
+		Height:          int32(header.Grid.Height),
+		Topology:        uint8(header.Grid.Topology),
+		Shape:           uint8(header.Grid.Shape),
+		GridFlags:       gridFlags,
+		TileCount:       uint32(len(tiles)),
+	}
+	if err := binary.Write(buffered, binary.LittleEndian, fixed); err != nil {
+		return err
+	}
+
+	for _, tile := range tiles {
+		record := binaryTile{
+			Q:         int32(tile.Coordinates.Q),
+			R:         int32(tile.Coordinates.R),
+			Elevation: float32(tile.Elevation),
+		}
+		if tile.IsLand {
+			record.Flags |= tileFlagLand
+		}
+		if err := binary.Write(buffered, binary.LittleEndian, record); err != nil {
+			return err
+		}
+	}
+
+	return buffered.Flush()
+}
+
+// ReadBinary reads terrain written by WriteBinary
+func ReadBinary(r io.Reader) (TerrainStreamHeader, []*HexTile, error) {
+	buffered := bufio.NewReader(r)
+
+	var magic [4]byte
+	if _, err := io.ReadFull(buffered, magic[:]); err != nil {
+		return TerrainStreamHeader{}, nil, err
+	}
+	if magic != binaryMagic {
+		return TerrainStreamHeader{}, nil, &TerrainError{"not a binary terrain file"}
+	}
+
+	var version uint16
+	if err := binary.Read(buffered, binary.LittleEndian, &version); err != nil {
+		return TerrainStreamHeader{}, nil, err
+	}
+	if version != BinaryVersion {
+		return TerrainStreamHeader{}, nil, &TerrainError{"unsupported binary terrain version"}
+	}
+
+	var fixed binaryHeader
+	if err := binary.Read(buffered, binary.LittleEndian, &fixed); err != nil {
+		return TerrainStreamHeader{}, nil, err
+	}
+
+	header := TerrainStreamHeader{
+		Config: TerrainConfig{
+			Seed:      fixed.Seed,
+			SeaLevel:  fixed.SeaLevel,
+			LandRatio: fixed.LandRatio,
+			NoiseParams: NoiseParameters{
+				Type:        NoiseType(fixed.NoiseType),
+				Octaves:     int(fixed.Octaves),
+				Persistence: fixed.Persistence,
+				Lacunarity:  fixed.Lacunarity,
+				Scale:       fixed.Scale,
+				HurstExp:    fixed.HurstExp,
+				Parallel:    fixed.NoiseFlags&(1<<0) != 0,
+				Tileable:    fixed.NoiseFlags&(1<<1) != 0,
+			},
+			Falloff:         FalloffShape(fixed.Falloff),
+			FalloffStrength: fixed.FalloffStrength,
+		},
+		Grid: GridInfo{
+			Width:             int(fixed.Width),
+			Height:            int(fixed.Height),
+			Topology:          hex.Topology(fixed.Topology),
+			Shape:             hex.Shape(fixed.Shape),
+			SphericalDistance: fixed.GridFlags&(1<<1) != 0,
+		},
+	}
+	if fixed.GridFlags&(1<<0) != 0 {
+		header.Grid.Orientation = hex.PointyTop
+	}
+
+	// Cap the initial allocation so a corrupt count cannot exhaust memory
+	tiles := make([]*HexTile, 0, min(int(fixed.TileCount), math.MaxUint16))
+	for i := uint32(0); i < fixed.TileCount; i++ {
+		var record binaryTile
+		if err := binary.Read(buffered, binary.LittleEndian, &record); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return TerrainStreamHeader{}, nil, err
+		}
+		tiles = append(tiles, &HexTile{
+			Coordinates: hex.NewAxialCoord(int(record.Q), int(record.R)),
+			Elevation:   float64(record.Elevation),
+			IsLand:      record.Flags&tileFlagLand != 0,
+		})
+	}
+
+	return header, tiles, nil
+}
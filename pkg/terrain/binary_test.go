@@ -0,0 +1,90 @@
+package terrain
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{
+		Width:             40,
+		Height:            30,
+		Topology:          hex.TopologyWorld,
+		Orientation:       hex.PointyTop,
+		SphericalDistance: true,
+	})
+	config := DefaultTerrainConfig()
+	config.NoiseParams.Tileable = true
+	config.Falloff = FalloffRadial
+	config.FalloffStrength = 2.0
+
+	tiles, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+	header := TerrainStreamHeader{Config: config, Grid: NewGridInfo(grid)}
+
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, header, tiles); err != nil {
+		t.Fatalf("WriteBinary failed: %v", err)
+	}
+	binarySize := buf.Len()
+
+	gotHeader, gotTiles, err := ReadBinary(&buf)
+	if err != nil {
+		t.Fatalf("ReadBinary failed: %v", err)
+	}
+
+	if gotHeader != header {
+		t.Errorf("Header mismatch: got %+v, want %+v", gotHeader, header)
+	}
+	if len(gotTiles) != len(tiles) {
+		t.Fatalf("Expected %d tiles, got %d", len(tiles), len(gotTiles))
+	}
+	for i, tile := range gotTiles {
+		want := tiles[i]
+		if tile.Coordinates != want.Coordinates || tile.IsLand != want.IsLand {
+			t.Errorf("Tile %d: got %+v, want %+v", i, *tile, *want)
+		}
+		// Elevations are stored at float32 precision
+		if math.Abs(tile.Elevation-want.Elevation) > 1e-3 {
+			t.Errorf("Tile %d elevation: got %f, want %f", i, tile.Elevation, want.Elevation)
+		}
+	}
+
+	jsonData, err := json.Marshal(&TerrainFile{Config: config, Grid: header.Grid, Tiles: tiles})
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	if binarySize*4 > len(jsonData) {
+		t.Errorf("Binary size %d is not at least 4x smaller than JSON size %d", binarySize, len(jsonData))
+	}
+}
+
+func TestReadBinaryErrors(t *testing.T) {
+	if _, _, err := ReadBinary(bytes.NewReader([]byte("JSON{}"))); err == nil {
+		t.Error("Expected error for bad magic number")
+	}
+
+	var buf bytes.Buffer
+	tiles := []*HexTile{{Coordinates: hex.NewAxialCoord(1, 2), Elevation: 10, IsLand: true}}
+	if err := WriteBinary(&buf, TerrainStreamHeader{}, tiles); err != nil {
+		t.Fatalf("WriteBinary failed: %v", err)
+	}
+	data := buf.Bytes()
+
+	// Future versions are rejected rather than misread
+	future := append([]byte(nil), data...)
+	future[4] = byte(BinaryVersion + 1)
+	if _, _, err := ReadBinary(bytes.NewReader(future)); err == nil {
+		t.Error("Expected error for unsupported version")
+	}
+
+	if _, _, err := ReadBinary(bytes.NewReader(data[:len(data)-3])); err == nil {
+		t.Error("Expected error for truncated tile data")
+	}
+}
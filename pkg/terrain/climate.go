@@ -0,0 +1,191 @@
+package terrain
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// orographicRise is the elevation gain (m) over one hex step at which rising
+// air drops the full ShadowStrength fraction of its moisture
+const orographicRise = 3000.0
+
+// MoistureConfig controls moisture map generation
+type MoistureConfig struct {
+	Wind           hex.AxialCoord `json:"wind"`            // Prevailing wind as an axial step (direction air moves)
+	CoastalReach   float64        `json:"coastal_reach"`   // Hex steps over which air dries out inland
+	ShadowRange    int            `json:"shadow_range"`    // Hex steps upwind that incoming air is traced
+	ShadowStrength float64        `json:"shadow_strength"` // Maximum fraction of moisture dropped by air rising over a slope
+	Variation      float64        `json:"variation"`       // Amplitude of seeded random variation
+}
+
+// DefaultMoistureConfig returns moisture parameters with a wind blowing toward +q
+func DefaultMoistureConfig() MoistureConfig {
+	return MoistureConfig{
+		Wind:           hex.NewAxialCoord(1, 0),
+		CoastalReach:   6.0,
+		ShadowRange:    8,
+		ShadowStrength: 0.8,
+		Variation:      0.05,
+	}
+}
+
+// GenerateMoisture produces a normalized [0,1] moisture value for each tile
+// using the default moisture configuration
+func GenerateMoisture(tiles []*HexTile, grid *hex.Grid, seed int64) map[hex.AxialCoord]float64 {
+	return GenerateMoistureWithConfig(tiles, grid, seed, DefaultMoistureConfig())
+}
+
+// GenerateMoistureWithConfig produces a normalized [0,1] moisture value for each
+// tile. Air carried by the prevailing wind is saturated over water, dries out
+// gradually over land, and drops precipitation as it rises over higher terrain,
+// so windward slopes are wet and leeward slopes lie in a dry rain shadow.
+// Output is deterministic per seed
+func GenerateMoistureWithConfig(tiles []*HexTile, grid *hex.Grid, seed int64, config MoistureConfig) map[hex.AxialCoord]float64 {
+	rng := rand.New(rand.NewSource(seed))
+	tileMap := indexTiles(tiles)
+	distances := waterDistances(tiles, grid)
+	moisture := make(map[hex.AxialCoord]float64, len(tiles))
+
+	for _, tile := range tiles {
+		jitter := (rng.Float64()*2 - 1) * config.Variation
+
+		if !tile.IsLand {
+			moisture[tile.Coordinates] = 1.0
+			continue
+		}
+
+		value := carriedMoisture(upwindPath(tile, tileMap, grid, config), distances, config)
+		moisture[tile.Coordinates] = clamp01(value + jitter)
+	}
+
+	return moisture
+}
+
+// upwindPath returns the tiles air crosses on its way to a tile, starting with
+// the tile itself and walking up to ShadowRange steps against the wind
+func upwindPath(tile *HexTile, tileMap map[hex.AxialCoord]*HexTile, grid *hex.Grid, config MoistureConfig) []*HexTile {
+	path := []*HexTile{tile}
+
+	current := tile.Coordinates
+	for step := 0; step < config.ShadowRange; step++ {
+		current = hex.NewAxialCoord(current.Q-config.Wind.Q, current.R-config.Wind.R)
+		if !grid.IsValid(current) {
+			break
+		}
+
+		upwind, ok := tileMap[grid.WrapCoord(current)]
+		if !ok {
+			break
+		}
+		path = append(path, upwind)
+	}
+
+	return path
+}
+
+// carriedMoisture moves a parcel of air downwind along an upwind path and
+// returns the moisture it delivers to the path's first tile. The parcel starts
+// with the coastal humidity of the farthest tile, is resaturated over water,
+// dries by a factor of e per CoastalReach steps over land, and loses up to
+// ShadowStrength of its moisture wherever the ground rises beneath it. The
+// delivered value counts the final tile's own precipitation, so a windward
+// slope is as wet as the air arriving at it
+func carriedMoisture(path []*HexTile, distances map[hex.AxialCoord]int, config MoistureConfig) float64 {
+	drying := 0.0
+	if config.CoastalReach > 0 {
+		drying = math.Exp(-1.0 / config.CoastalReach)
+	}
+
+	// Air entering the traced path has the humidity of its starting coast distance
+	start := path[len(path)-1]
+	humidity := 1.0
+	if start.IsLand {
+		humidity = 0.0
+		if distance, ok := distances[start.Coordinates]; ok && config.CoastalReach > 0 {
+			humidity = math.Exp(-float64(distance) / config.CoastalReach)
+		}
+	}
+
+	delivered := humidity
+	for i := len(path) - 2; i >= 0; i-- {
+		current, previous := path[i], path[i+1]
+		if !current.IsLand {
+			humidity = 1.0
+			delivered = humidity
+			continue
+		}
+
+		humidity *= drying
+		delivered = humidity
+
+		// Orographic lift: rising air cools and rains out part of its moisture
+		rise := math.Max(current.Elevation, 0) - math.Max(previous.Elevation, 0)
+		humidity -= humidity * config.ShadowStrength * clamp01(rise/orographicRise)
+	}
+
+	return delivered
+}
+
+// clamp01 restricts a value to the [0,1] range
+func clamp01(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
+
+// TemperatureConfig controls temperature map generation
+type TemperatureConfig struct {
+	EquatorTemp float64 `json:"equator_temp"` // Sea-level temperature at the equator (°C)
+	PoleTemp    float64 `json:"pole_temp"`    // Sea-level temperature at the poles (°C)
+	LapseRate   float64 `json:"lapse_rate"`   // Cooling per 1000m of elevation (°C)
+}
+
+// DefaultTemperatureConfig returns Earth-like temperature parameters
+func DefaultTemperatureConfig() TemperatureConfig {
+	return TemperatureConfig{
+		EquatorTemp: 27.0,
+		PoleTemp:    -25.0,
+		LapseRate:   6.5, // Standard atmospheric lapse rate
+	}
+}
+
+// GenerateTemperature returns the temperature in °C for each tile using the
+// default temperature configuration
+func GenerateTemperature(tiles []*HexTile, grid *hex.Grid) map[hex.AxialCoord]float64 {
+	return GenerateTemperatureWithConfig(tiles, grid, DefaultTemperatureConfig())
+}
+
+// GenerateTemperatureWithConfig returns the temperature in °C for each tile.
+// Rows of the grid's offset bounding box map from the north pole (first row)
+// through the equator (middle row) to the south pole (last row), so shaped
+// grids span the full latitude range; land cools with elevation at the lapse rate
+func GenerateTemperatureWithConfig(tiles []*HexTile, grid *hex.Grid, config TemperatureConfig) map[hex.AxialCoord]float64 {
+	temperature := make(map[hex.AxialCoord]float64, len(tiles))
+	_, minRow, _, maxRow := grid.Bounds()
+
+	for _, tile := range tiles {
+		_, row := tile.Coordinates.ToOffset()
+		latitude := rowLatitude(row-minRow, maxRow-minRow+1)
+
+		// Insolation falls off with the cosine of latitude
+		seaLevelTemp := config.PoleTemp +
+			(config.EquatorTemp-config.PoleTemp)*math.Cos(latitude*math.Pi/2)
+
+		// Adiabatic cooling with altitude (water surfaces sit at sea level)
+		altitude := math.Max(tile.Elevation, 0)
+		temperature[tile.Coordinates] = seaLevelTemp - config.LapseRate*altitude/1000.0
+	}
+
+	return temperature
+}
+
+// rowLatitude maps a row to an absolute latitude fraction, 0 at the equator
+// row and 1 at the first and last rows, symmetric around the equator
+func rowLatitude(row, height int) float64 {
+	if height <= 1 {
+		return 0
+	}
+
+	equator := float64(height-1) / 2.0
+	return math.Min(1, math.Abs(float64(row)-equator)/equator)
+}
@@ -0,0 +1,190 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// ridgeTiles creates land between seas in columns 0 and 15, with a tall
+// north-south ridge in column 6
+func ridgeTiles(grid *hex.Grid) []*HexTile {
+	return elevationTiles(grid, func(col, _ int) float64 {
+		switch {
+		case col == 0 || col == 15:
+			return -500.0
+		case col == 6:
+			return 4000.0
+		}
+		return 200.0
+	})
+}
+
+// columnMean averages a per-hex value over one offset column
+func columnMean(values map[hex.AxialCoord]float64, col, height int) float64 {
+	sum := 0.0
+	for row := 0; row < height; row++ {
+		sum += values[hex.OffsetToAxial(col, row)]
+	}
+	return sum / float64(height)
+}
+
+func TestGenerateMoisture(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 16, Height: 8, Topology: hex.TopologyRegion})
+	tiles := ridgeTiles(grid)
+
+	moisture := GenerateMoisture(tiles, grid, 42)
+
+	if len(moisture) != len(tiles) {
+		t.Fatalf("Expected moisture for %d tiles, got %d", len(tiles), len(moisture))
+	}
+
+	for coord, value := range moisture {
+		if value < 0 || value > 1 {
+			t.Errorf("Moisture at %v out of range: %f", coord, value)
+		}
+	}
+
+	// Coastal land is wetter than inland
+	if columnMean(moisture, 1, 8) <= columnMean(moisture, 4, 8) {
+		t.Error("Expected coastal tiles to be wetter than inland tiles")
+	}
+
+	// Columns 5 and 10 are equally far from the sea, but 10 lies behind the ridge
+	windward := columnMean(moisture, 5, 8)
+	leeward := columnMean(moisture, 10, 8)
+	if leeward >= windward {
+		t.Errorf("Expected rain shadow: windward %.3f, leeward %.3f", windward, leeward)
+	}
+
+	// Deterministic per seed
+	again := GenerateMoisture(tiles, grid, 42)
+	for coord, value := range moisture {
+		if again[coord] != value {
+			t.Errorf("Non-deterministic moisture at %v: %f vs %f", coord, value, again[coord])
+		}
+	}
+}
+
+func TestGenerateMoistureWindDirection(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 16, Height: 8, Topology: hex.TopologyRegion})
+	tiles := ridgeTiles(grid)
+
+	// Reversing the wind moves the rain shadow to the other side of the ridge
+	config := DefaultMoistureConfig()
+	config.Wind = hex.NewAxialCoord(-1, 0)
+	config.Variation = 0
+
+	moisture := GenerateMoistureWithConfig(tiles, grid, 42, config)
+
+	if columnMean(moisture, 5, 8) >= columnMean(moisture, 10, 8) {
+		t.Error("Expected rain shadow west of the ridge with a westward wind")
+	}
+}
+
+func TestGenerateTemperature(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 6, Height: 21, Topology: hex.TopologyWorld})
+	tiles := buildTiles(grid, func(col, row int) bool { return false })
+	tileMap := indexTiles(tiles)
+
+	// Raise a tall equatorial mountain
+	peak := hex.OffsetToAxial(2, 10)
+	tileMap[peak].Elevation = 6000
+	tileMap[peak].ClassifyLandWater(0.0)
+
+	temperature := GenerateTemperature(tiles, grid)
+	config := DefaultTemperatureConfig()
+
+	equator := temperature[hex.OffsetToAxial(0, 10)]
+	if equator != config.EquatorTemp {
+		t.Errorf("Expected equator temperature %.1f, got %.1f", config.EquatorTemp, equator)
+	}
+
+	north := temperature[hex.OffsetToAxial(0, 0)]
+	south := temperature[hex.OffsetToAxial(0, 20)]
+	if math.Abs(north-config.PoleTemp) > 1e-9 || math.Abs(south-config.PoleTemp) > 1e-9 {
+		t.Errorf("Expected pole temperatures %.1f, got %.1f and %.1f", config.PoleTemp, north, south)
+	}
+
+	// Latitude is symmetric around the equator row
+	for offset := 1; offset <= 10; offset++ {
+		above := temperature[hex.OffsetToAxial(0, 10-offset)]
+		below := temperature[hex.OffsetToAxial(0, 10+offset)]
+		if math.Abs(above-below) > 1e-9 {
+			t.Errorf("Asymmetric temperature %d rows from equator: %.2f vs %.2f", offset, above, below)
+		}
+	}
+
+	// A high equatorial peak can be cold despite its latitude
+	expectedPeak := config.EquatorTemp - config.LapseRate*6.0
+	if math.Abs(temperature[peak]-expectedPeak) > 1e-9 {
+		t.Errorf("Expected peak temperature %.1f, got %.1f", expectedPeak, temperature[peak])
+	}
+	if temperature[peak] >= 0 {
+		t.Errorf("Expected freezing equatorial peak, got %.1f°C", temperature[peak])
+	}
+}
+
+func TestGenerateTemperatureShapedGrid(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 21, Shape: hex.ShapeHexagon, Topology: hex.TopologyRegion})
+	tiles := buildTiles(grid, func(col, row int) bool { return false })
+
+	temperature := GenerateTemperature(tiles, grid)
+	config := DefaultTemperatureConfig()
+
+	// The hexagon's top and bottom rows are its poles and its middle row the equator
+	_, minRow, _, maxRow := grid.Bounds()
+	coldest, warmest := math.Inf(1), math.Inf(-1)
+	for _, tile := range tiles {
+		value := temperature[tile.Coordinates]
+		coldest = math.Min(coldest, value)
+		warmest = math.Max(warmest, value)
+
+		_, row := tile.Coordinates.ToOffset()
+		if (row == minRow || row == maxRow) && math.Abs(value-config.PoleTemp) > 1e-9 {
+			t.Errorf("Expected polar temperature %.1f at %v, got %.1f", config.PoleTemp, tile.Coordinates, value)
+		}
+	}
+
+	if math.Abs(coldest-config.PoleTemp) > 1e-9 || math.Abs(warmest-config.EquatorTemp) > 1e-9 {
+		t.Errorf("Expected temperatures from %.1f to %.1f, got %.1f to %.1f",
+			config.PoleTemp, config.EquatorTemp, coldest, warmest)
+	}
+}
+
+func TestGenerateMoistureRainShadow(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 16, Height: 8, Topology: hex.TopologyRegion})
+	config := DefaultMoistureConfig()
+	config.Variation = 0
+
+	ridge := GenerateMoistureWithConfig(ridgeTiles(grid), grid, 42, config)
+
+	// The same land with the ridge flattened has no rain shadow
+	flat := ridgeTiles(grid)
+	for _, tile := range flat {
+		if tile.IsLand {
+			tile.Elevation = 200.0
+		}
+	}
+	plain := GenerateMoistureWithConfig(flat, grid, 42, config)
+
+	// Leeward slope directly behind the ridge is much drier than the windward slope
+	windward := columnMean(ridge, 5, 8)
+	leeward := columnMean(ridge, 7, 8)
+	if leeward > windward*0.5 {
+		t.Errorf("Expected leeward slope well below windward: windward %.3f, leeward %.3f", windward, leeward)
+	}
+
+	// The drying comes from the ridge, not distance from the coast
+	if leeward > columnMean(plain, 7, 8)*0.5 {
+		t.Errorf("Expected ridge to dry leeward column: with ridge %.3f, without %.3f",
+			leeward, columnMean(plain, 7, 8))
+	}
+
+	// Windward side is unaffected by the ridge behind it
+	if math.Abs(windward-columnMean(plain, 5, 8)) > 1e-9 {
+		t.Errorf("Expected windward moisture unchanged by ridge: %.3f vs %.3f",
+			windward, columnMean(plain, 5, 8))
+	}
+}
@@ -0,0 +1,57 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// TerrainDiff summarizes the differences between two terrains
+type TerrainDiff struct {
+	Deltas      map[hex.AxialCoord]float64 // Elevation change (b - a) per shared tile
+	Compared    int                        // Tiles present in both terrains
+	Unmatched   int                        // Tiles present in only one terrain
+	Flipped     int                        // Tiles that changed between land and water
+	LandToWater int                        // Land tiles in a that are water in b
+	WaterToLand int                        // Water tiles in a that are land in b
+	MaxDelta    float64                    // Largest absolute elevation change
+	MeanDelta   float64                    // Mean signed elevation change
+	RMSDelta    float64                    // Root mean square elevation change
+}
+
+// DiffTerrain compares two terrains tile by tile, matching tiles by coordinate
+func DiffTerrain(a, b []*HexTile) TerrainDiff {
+	tilesB := indexTiles(b)
+	diff := TerrainDiff{Deltas: make(map[hex.AxialCoord]float64, len(a))}
+
+	var sum, sumSquares float64
+	for _, tileA := range a {
+		tileB, ok := tilesB[tileA.Coordinates]
+		if !ok {
+			diff.Unmatched++
+			continue
+		}
+
+		delta := tileB.Elevation - tileA.Elevation
+		diff.Deltas[tileA.Coordinates] = delta
+		diff.Compared++
+		sum += delta
+		sumSquares += delta * delta
+		diff.MaxDelta = math.Max(diff.MaxDelta, math.Abs(delta))
+
+		if tileA.IsLand && !tileB.IsLand {
+			diff.LandToWater++
+		} else if !tileA.IsLand && tileB.IsLand {
+			diff.WaterToLand++
+		}
+	}
+	diff.Flipped = diff.LandToWater + diff.WaterToLand
+	diff.Unmatched += len(b) - diff.Compared
+
+	if diff.Compared > 0 {
+		diff.MeanDelta = sum / float64(diff.Compared)
+		diff.RMSDelta = math.Sqrt(sumSquares / float64(diff.Compared))
+	}
+
+	return diff
+}
@@ -0,0 +1,61 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestDiffTerrainIdentical(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 20, Height: 15})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+
+	diff := DiffTerrain(tiles, tiles)
+
+	if diff.Compared != len(tiles) || diff.Unmatched != 0 {
+		t.Errorf("Expected %d compared and 0 unmatched, got %d and %d", len(tiles), diff.Compared, diff.Unmatched)
+	}
+	for coord, delta := range diff.Deltas {
+		if delta != 0 {
+			t.Errorf("Non-zero delta %f at %v", delta, coord)
+		}
+	}
+	if diff.Flipped != 0 || diff.MaxDelta != 0 || diff.MeanDelta != 0 || diff.RMSDelta != 0 {
+		t.Errorf("Expected all-zero summary, got %+v", diff)
+	}
+}
+
+func TestDiffTerrainChanges(t *testing.T) {
+	a := []*HexTile{
+		{Coordinates: hex.NewAxialCoord(0, 0), Elevation: 100, IsLand: true},
+		{Coordinates: hex.NewAxialCoord(1, 0), Elevation: -50, IsLand: false},
+		{Coordinates: hex.NewAxialCoord(2, 0), Elevation: 10, IsLand: true},
+	}
+	b := []*HexTile{
+		{Coordinates: hex.NewAxialCoord(0, 0), Elevation: -100, IsLand: false},
+		{Coordinates: hex.NewAxialCoord(1, 0), Elevation: -50, IsLand: false},
+		{Coordinates: hex.NewAxialCoord(3, 0), Elevation: 10, IsLand: true},
+	}
+
+	diff := DiffTerrain(a, b)
+
+	if diff.Compared != 2 || diff.Unmatched != 2 {
+		t.Errorf("Expected 2 compared and 2 unmatched, got %d and %d", diff.Compared, diff.Unmatched)
+	}
+	if diff.Deltas[hex.NewAxialCoord(0, 0)] != -200 {
+		t.Errorf("Expected delta -200, got %f", diff.Deltas[hex.NewAxialCoord(0, 0)])
+	}
+	if diff.Flipped != 1 || diff.LandToWater != 1 || diff.WaterToLand != 0 {
+		t.Errorf("Expected one land-to-water flip, got %+v", diff)
+	}
+	if diff.MaxDelta != 200 || diff.MeanDelta != -100 {
+		t.Errorf("Expected max 200 and mean -100, got %f and %f", diff.MaxDelta, diff.MeanDelta)
+	}
+	if math.Abs(diff.RMSDelta-math.Sqrt(20000)) > 1e-9 {
+		t.Errorf("Expected RMS %f, got %f", math.Sqrt(20000), diff.RMSDelta)
+	}
+}
@@ -0,0 +1,112 @@
+package terrain
+
+// ApplyThermalErosion softens slopes steeper than the talus angle by moving
+// material from each cell to its lower hex neighbors, producing natural scree
+// slopes instead of sharp cliffs. talusAngle is the maximum stable height
+// difference between neighboring cells (the tangent of the talus angle for
+// unit cell spacing). It operates on the raw heightmap, before
+// ApplyHypsometricCurve, and returns a new heightmap; total material is conserved
+func ApplyThermalErosion(heightmap [][]float64, talusAngle float64, iterations int) [][]float64 {
+	result := copyHeightmap(heightmap)
+	if len(result) == 0 {
+		return result
+	}
+
+	height := len(result)
+	width := len(result[0])
+	delta := make([][]float64, height)
+	for i := range delta {
+		delta[i] = make([]float64, width)
+	}
+
+	for iter := 0; iter < iterations; iter++ {
+		moved := false
+
+		// Compute all transfers from the current state before applying any,
+		// so the result doesn't depend on traversal order
+		for row := 0; row < height; row++ {
+			for col := 0; col < width; col++ {
+				current := result[row][col]
+				neighbors := offsetNeighbors(col, row, width, height)
+
+				maxExcess := 0.0
+				totalExcess := 0.0
+				for _, n := range neighbors {
+					excess := current - result[n[1]][n[0]] - talusAngle
+					if excess > 0 {
+						totalExcess += excess
+						if excess > maxExcess {
+							maxExcess = excess
+						}
+					}
+				}
+
+				if totalExcess == 0 {
+					continue
+				}
+				moved = true
+
+				// Move half the steepest excess, split in proportion to each
+				// neighbor's excess. Dividing by the neighbor count keeps
+				// simultaneous transfers from overshooting
+				amount := maxExcess / 2 / float64(len(neighbors))
+				for _, n := range neighbors {
+					excess := current - result[n[1]][n[0]] - talusAngle
+					if excess > 0 {
+						share := amount * excess / totalExcess
+						delta[row][col] -= share
+						delta[n[1]][n[0]] += share
+					}
+				}
+			}
+		}
+
+		for row := range result {
+			for col := range result[row] {
+				result[row][col] += delta[row][col]
+				delta[row][col] = 0
+			}
+		}
+
+		if !moved {
+			break
+		}
+	}
+
+	return result
+}
+
+// offsetNeighbors returns the in-bounds hex neighbors of a heightmap cell,
+// using the even-q offset layout that maps heightmap cells to hexes
+func offsetNeighbors(col, row, width, height int) [][2]int {
+	var candidates [6][2]int
+	if col&1 == 0 {
+		candidates = [6][2]int{
+			{col + 1, row}, {col + 1, row + 1}, {col, row + 1},
+			{col - 1, row + 1}, {col - 1, row}, {col, row - 1},
+		}
+	} else {
+		candidates = [6][2]int{
+			{col + 1, row - 1}, {col + 1, row}, {col, row + 1},
+			{col - 1, row}, {col - 1, row - 1}, {col, row - 1},
+		}
+	}
+
+	neighbors := make([][2]int, 0, 6)
+	for _, c := range candidates {
+		if c[0] >= 0 && c[0] < width && c[1] >= 0 && c[1] < height {
+			neighbors = append(neighbors, c)
+		}
+	}
+	return neighbors
+}
+
+// copyHeightmap returns a deep copy of a heightmap
+func copyHeightmap(heightmap [][]float64) [][]float64 {
+	result := make([][]float64, len(heightmap))
+	for i := range heightmap {
+		result[i] = make([]float64, len(heightmap[i]))
+		copy(result[i], heightmap[i])
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestOffsetNeighborsMatchHexNeighbors(t *testing.T) {
+	width, height := 7, 6
+	grid := hex.NewGrid(hex.GridConfig{Width: width, Height: height, Topology: hex.TopologyRegion})
+
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			expected := make(map[[2]int]bool)
+			for _, n := range hex.OffsetToAxial(col, row).Neighbors(grid) {
+				nc, nr := n.ToOffset()
+				expected[[2]int{nc, nr}] = true
+			}
+
+			neighbors := offsetNeighbors(col, row, width, height)
+			if len(neighbors) != len(expected) {
+				t.Errorf("(%d,%d): expected %d neighbors, got %d", col, row, len(expected), len(neighbors))
+			}
+			for _, n := range neighbors {
+				if !expected[n] {
+					t.Errorf("(%d,%d): %v is not a hex neighbor", col, row, n)
+				}
+			}
+		}
+	}
+}
+
+func TestApplyThermalErosion(t *testing.T) {
+	heightmap := GenerateHeightmap(32, 24, DefaultNoiseParameters(), 42)
+
+	// Add a sharp cliff so there is something to erode
+	for row := range heightmap {
+		for col := 16; col < len(heightmap[row]); col++ {
+			heightmap[row][col] += 1.0
+		}
+	}
+
+	talus := 0.05
+	eroded := ApplyThermalErosion(heightmap, talus, 3000)
+
+	if len(eroded) != len(heightmap) || len(eroded[0]) != len(heightmap[0]) {
+		t.Fatal("Erosion changed heightmap dimensions")
+	}
+
+	// No neighbor pair may exceed the talus threshold
+	tolerance := 1e-3
+	height, width := len(eroded), len(eroded[0])
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			for _, n := range offsetNeighbors(col, row, width, height) {
+				slope := math.Abs(eroded[row][col] - eroded[n[1]][n[0]])
+				if slope > talus+tolerance {
+					t.Fatalf("Slope %.4f between (%d,%d) and %v exceeds talus %.2f",
+						slope, col, row, n, talus)
+				}
+			}
+		}
+	}
+
+	// Material is conserved
+	before, after := 0.0, 0.0
+	for row := range heightmap {
+		for col := range heightmap[row] {
+			before += heightmap[row][col]
+			after += eroded[row][col]
+		}
+	}
+	if math.Abs(before-after) > 1e-6 {
+		t.Errorf("Erosion changed total material: %f vs %f", before, after)
+	}
+
+	// The input is not modified and zero iterations is a no-op
+	unchanged := ApplyThermalErosion(heightmap, talus, 0)
+	for row := range heightmap {
+		for col := range heightmap[row] {
+			if unchanged[row][col] != heightmap[row][col] {
+				t.Fatalf("Zero iterations changed cell (%d,%d)", col, row)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package terrain
+
+import (
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// FeatureType classifies the local shape of the terrain around a tile
+type FeatureType int
+
+const (
+	FeaturePeak   FeatureType = iota // Higher than every neighbor
+	FeaturePit                       // Lower than every neighbor
+	FeatureRidge                     // Higher than both neighbors across some axis
+	FeatureValley                    // Lower than both neighbors across some axis
+	FeaturePass                      // Saddle: alternating higher and lower neighbors
+)
+
+// TerrainFeature is a notable terrain feature at a single tile
+type TerrainFeature struct {
+	Type        FeatureType    `json:"type"`
+	Coordinates hex.AxialCoord `json:"coordinates"`
+}
+
+// DetectFeatures classifies tiles by comparing their elevation with their six
+// neighbors, walked in order around the hex. A peak or pit is a strict local
+// maximum or minimum; a pass has at least two separate higher and lower arcs
+// of neighbors. Remaining tiles with one higher and one lower arc are ridges
+// if they rise above both neighbors along some axis and valleys if they dip
+// below both. Tiles without a full ring of neighbors are skipped, and equal
+// elevations are ordered by coordinate so flat areas classify consistently
+func DetectFeatures(tiles []*HexTile, grid *hex.Grid) []TerrainFeature {
+	tileMap := indexTiles(tiles)
+	var features []TerrainFeature
+
+	for _, tile := range tiles {
+		// higher[i] records whether the neighbor in direction i is above the tile
+		var higher [6]bool
+		complete := true
+		for i, d := range hex.Directions {
+			coord, ok := grid.Neighbor(tile.Coordinates, d)
+			neighbor, exists := tileMap[coord]
+			if !ok || !exists {
+				complete = false
+				break
+			}
+			higher[i] = isHigher(neighbor, tile)
+		}
+		if !complete {
+			continue
+		}
+
+		featureType, ok := classifyRing(higher)
+		if ok {
+			features = append(features, TerrainFeature{Type: featureType, Coordinates: tile.Coordinates})
+		}
+	}
+
+	return features
+}
+
+// classifyRing classifies a tile from which of its neighbors, in ring order,
+// are higher than it
+func classifyRing(higher [6]bool) (FeatureType, bool) {
+	higherCount, changes := 0, 0
+	for i := range higher {
+		if higher[i] {
+			higherCount++
+		}
+		if higher[i] != higher[(i+1)%6] {
+			changes++
+		}
+	}
+
+	switch {
+	case higherCount == 0:
+		return FeaturePeak, true
+	case higherCount == 6:
+		return FeaturePit, true
+	case changes >= 4:
+		return FeaturePass, true
+	}
+
+	// Opposite neighbors sit three steps apart around the ring
+	for i := 0; i < 3; i++ {
+		if !higher[i] && !higher[i+3] {
+			return FeatureRidge, true
+		}
+		if higher[i] && higher[i+3] {
+			return FeatureValley, true
+		}
+	}
+
+	return 0, false
+}
+
+// isHigher orders tiles by elevation, breaking ties by coordinate
+func isHigher(a, b *HexTile) bool {
+	if a.Elevation != b.Elevation {
+		return a.Elevation > b.Elevation
+	}
+	if a.Coordinates.Q != b.Coordinates.Q {
+		return a.Coordinates.Q > b.Coordinates.Q
+	}
+	return a.Coordinates.R > b.Coordinates.R
+}
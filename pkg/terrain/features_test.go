@@ -0,0 +1,118 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// featureTiles builds tiles for every grid hex with elevations from fn
+func featureTiles(grid *hex.Grid, fn func(hex.AxialCoord) float64) []*HexTile {
+	var tiles []*HexTile
+	for _, coord := range grid.AllCoords() {
+		tiles = append(tiles, &HexTile{Coordinates: coord, Elevation: fn(coord)})
+	}
+	return tiles
+}
+
+// featureAt returns the feature detected at coord, if any
+func featureAt(features []TerrainFeature, coord hex.AxialCoord) (FeatureType, bool) {
+	for _, feature := range features {
+		if feature.Coordinates == coord {
+			return feature.Type, true
+		}
+	}
+	return 0, false
+}
+
+func TestDetectFeaturesPeakAndPit(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 7, Height: 7})
+	peak := hex.OffsetToAxial(2, 2)
+	pit := hex.OffsetToAxial(4, 4)
+
+	tiles := featureTiles(grid, func(c hex.AxialCoord) float64 {
+		switch c {
+		case peak:
+			return 3000
+		case pit:
+			return -3000
+		}
+		return 100
+	})
+
+	features := DetectFeatures(tiles, grid)
+
+	if got, ok := featureAt(features, peak); !ok || got != FeaturePeak {
+		t.Errorf("Expected a peak at %v, got %v (found %v)", peak, got, ok)
+	}
+	if got, ok := featureAt(features, pit); !ok || got != FeaturePit {
+		t.Errorf("Expected a pit at %v, got %v (found %v)", pit, got, ok)
+	}
+
+	// Edge hexes lack a full ring of neighbors
+	for _, feature := range features {
+		if feature.Coordinates.IsEdgeHex(grid) {
+			t.Errorf("Edge hex %v should not be classified", feature.Coordinates)
+		}
+	}
+}
+
+func TestDetectFeaturesRidgeValleyPass(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 9, Height: 9})
+	center := hex.OffsetToAxial(4, 4)
+
+	// Neighbor elevations by direction from center; other tiles sit at 0
+	build := func(ring [6]float64) []*HexTile {
+		return featureTiles(grid, func(c hex.AxialCoord) float64 {
+			if c == center {
+				return 0
+			}
+			if d, ok := hex.DirectionBetween(center, c); ok {
+				return ring[d]
+			}
+			return 0
+		})
+	}
+
+	tests := []struct {
+		ring     [6]float64
+		expected FeatureType
+	}{
+		{[6]float64{-1, -1, 1, -1, -1, -1}, FeatureRidge},
+		{[6]float64{-1, 1, 1, -1, -1, -1}, FeatureRidge},
+		{[6]float64{1, 1, -1, 1, 1, 1}, FeatureValley},
+		{[6]float64{1, 1, -1, -1, 1, 1}, FeatureValley},
+		{[6]float64{1, -1, 1, -1, 1, -1}, FeaturePass},
+		{[6]float64{1, 1, -1, -1, 1, -1}, FeaturePass},
+	}
+
+	for _, tt := range tests {
+		features := DetectFeatures(build(tt.ring), grid)
+		if got, ok := featureAt(features, center); !ok || got != tt.expected {
+			t.Errorf("Ring %v: expected feature %d, got %d (found %v)", tt.ring, tt.expected, got, ok)
+		}
+	}
+
+	// An even slope has no feature
+	features := DetectFeatures(build([6]float64{1, 1, 1, -1, -1, -1}), grid)
+	if got, ok := featureAt(features, center); ok {
+		t.Errorf("Slope should have no feature, got %d", got)
+	}
+}
+
+func TestDetectFeaturesWorld(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 8, Height: 6, Topology: hex.TopologyWorld})
+	corner := hex.NewAxialCoord(0, 0)
+
+	tiles := featureTiles(grid, func(c hex.AxialCoord) float64 {
+		if c == corner {
+			return 500
+		}
+		return 0
+	})
+
+	// World grids wrap, so even corner hexes have a full ring
+	if got, ok := featureAt(DetectFeatures(tiles, grid), corner); !ok || got != FeaturePeak {
+		t.Errorf("Expected a peak at the world corner, got %d (found %v)", got, ok)
+	}
+}
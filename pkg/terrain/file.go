@@ -0,0 +1,92 @@
+package terrain
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// GridInfo records the layout of the grid terrain was generated on
+type GridInfo struct {
+	Width             int             `json:"width"`                        // Grid width in columns
+	Height            int             `json:"height"`                       // Grid height in rows
+	Topology          hex.Topology    `json:"topology"`                     // 0 = region, 1 = world
+	Shape             hex.Shape       `json:"shape"`                        // 0 = rectangle, 1 = hexagon, 2 = rhombus, 3 = triangle
+	Orientation       hex.Orientation `json:"orientation"`                  // 0 = flat top, 1 = pointy top
+	SphericalDistance bool            `json:"spherical_distance,omitempty"` // Great-circle distances on world grids
+}
+
+// NewGridInfo captures the layout of a grid for serialization
+func NewGridInfo(grid *hex.Grid) GridInfo {
+	config := grid.Config()
+	return GridInfo{
+		Width:             config.Width,
+		Height:            config.Height,
+		Topology:          config.Topology,
+		Shape:             config.Shape,
+		Orientation:       config.Orientation,
+		SphericalDistance: config.SphericalDistance,
+	}
+}
+
+// GridConfig returns the configuration that rebuilds the recorded grid
+func (info GridInfo) GridConfig() hex.GridConfig {
+	return hex.GridConfig{
+		Width:             info.Width,
+		Height:            info.Height,
+		Topology:          info.Topology,
+		Shape:             info.Shape,
+		Orientation:       info.Orientation,
+		SphericalDistance: info.SphericalDistance,
+	}
+}
+
+// TerrainFile is the JSON document written by generate-terrain
+type TerrainFile struct {
+	Config TerrainConfig `json:"config"`
+	Grid   GridInfo      `json:"grid"`
+	Stats  TerrainStats  `json:"stats"`
+	Tiles  []*HexTile    `json:"tiles"`
+}
+
+// SaveTerrainFile writes terrain to path as indented JSON
+func SaveTerrainFile(path string, data *TerrainFile) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// LoadTerrainFile reads a terrain JSON file and reconstructs the grid it was
+// generated on. Files written before the grid section existed fall back to a
+// region grid just large enough to hold every tile
+func LoadTerrainFile(path string) (*hex.Grid, *TerrainFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	var data TerrainFile
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		return nil, nil, err
+	}
+
+	if data.Grid.Width == 0 && data.Grid.Height == 0 {
+		data.Grid = NewGridInfo(tileBoundsGrid(data.Tiles))
+	}
+
+	gridConfig := data.Grid.GridConfig()
+	if err := gridConfig.Validate(); err != nil {
+		return nil, nil, &TerrainError{err.Error()}
+	}
+	grid := hex.NewGrid(gridConfig)
+
+	return grid, &data, nil
+}
@@ -0,0 +1,130 @@
+package terrain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestTerrainFileRoundTrip(t *testing.T) {
+	// A pointy-top grid catches layouts that fall back to the flat-top default
+	grid := hex.NewGrid(hex.GridConfig{
+		Width:             12,
+		Height:            9,
+		Topology:          hex.TopologyWorld,
+		Orientation:       hex.PointyTop,
+		SphericalDistance: true,
+	})
+	config := DefaultTerrainConfig()
+	tiles, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "terrain.json")
+	saved := &TerrainFile{
+		Config: config,
+		Grid:   NewGridInfo(grid),
+		Stats:  ValidateTerrain(tiles),
+		Tiles:  tiles,
+	}
+	if err := SaveTerrainFile(path, saved); err != nil {
+		t.Fatalf("SaveTerrainFile() failed: %v", err)
+	}
+
+	loadedGrid, loaded, err := LoadTerrainFile(path)
+	if err != nil {
+		t.Fatalf("LoadTerrainFile() failed: %v", err)
+	}
+
+	if loadedGrid.Config() != grid.Config() {
+		t.Errorf("Grid config = %+v, expected %+v", loadedGrid.Config(), grid.Config())
+	}
+	if loaded.Config.Seed != config.Seed {
+		t.Errorf("Seed = %d, expected %d", loaded.Config.Seed, config.Seed)
+	}
+	if len(loaded.Tiles) != len(tiles) {
+		t.Fatalf("Loaded %d tiles, expected %d", len(loaded.Tiles), len(tiles))
+	}
+	for i, tile := range loaded.Tiles {
+		if tile.Coordinates != tiles[i].Coordinates || tile.Elevation != tiles[i].Elevation {
+			t.Errorf("Tile %d = %+v, expected %+v", i, tile, tiles[i])
+		}
+	}
+
+	// The reconstructed grid wraps like the original
+	edge := hex.OffsetToAxial(11, 4)
+	if len(edge.Neighbors(loadedGrid)) != 6 {
+		t.Errorf("Expected 6 neighbors on a world grid edge, got %d", len(edge.Neighbors(loadedGrid)))
+	}
+}
+
+func TestLoadTerrainFileWithoutGrid(t *testing.T) {
+	// Files written before the grid section existed infer a region grid
+	path := filepath.Join(t.TempDir(), "legacy.json")
+	legacy := `{"tiles": [
+		{"coordinates": {"Q": 0, "R": 0}, "elevation": 10},
+		{"coordinates": {"Q": 3, "R": 1}, "elevation": -10}
+	]}`
+	if err := os.WriteFile(path, []byte(legacy), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grid, loaded, err := LoadTerrainFile(path)
+	if err != nil {
+		t.Fatalf("LoadTerrainFile() failed: %v", err)
+	}
+
+	col, row := hex.NewAxialCoord(3, 1).ToOffset()
+	expected := hex.GridConfig{Width: col + 1, Height: row + 1, Topology: hex.TopologyRegion}
+	if grid.Config() != expected {
+		t.Errorf("Inferred grid %+v, expected %+v", grid.Config(), expected)
+	}
+	if len(loaded.Tiles) != 2 {
+		t.Errorf("Expected 2 tiles, got %d", len(loaded.Tiles))
+	}
+}
+
+func TestLoadTerrainFileErrors(t *testing.T) {
+	if _, _, err := LoadTerrainFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(path, []byte(`{"grid": {"width": 4, "height": 4, "topology": 7}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := LoadTerrainFile(path); err == nil {
+		t.Error("Expected error for unknown topology")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"grid": {"width": 4, "height": 4, "topology": 1, "shape": 1}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := LoadTerrainFile(path); err == nil {
+		t.Error("Expected error for a wrapping hexagon grid")
+	}
+}
+
+func TestTerrainFileShapedGrid(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 9, Topology: hex.TopologyRegion, Shape: hex.ShapeHexagon})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "hexagon.json")
+	if err := SaveTerrainFile(path, &TerrainFile{Grid: NewGridInfo(grid), Tiles: tiles}); err != nil {
+		t.Fatalf("SaveTerrainFile() failed: %v", err)
+	}
+
+	loadedGrid, _, err := LoadTerrainFile(path)
+	if err != nil {
+		t.Fatalf("LoadTerrainFile() failed: %v", err)
+	}
+	if len(loadedGrid.AllCoords()) != len(tiles) {
+		t.Errorf("Loaded grid has %d hexes, expected %d", len(loadedGrid.AllCoords()), len(tiles))
+	}
+}
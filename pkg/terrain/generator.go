@@ -2,41 +2,117 @@ package terrain
 
 import (
 	"math"
+	"runtime"
 	"sort"
+	"sync"
 
 	"github.com/sean/hex-map/internal/noise"
 	"github.com/sean/hex-map/pkg/hex"
 )
 
-// GenerateTerrain creates a complete terrain with elevation and land/water classification
+// GenerateTerrain creates a complete terrain with elevation and land/water classification.
+// When config.Rand is set, one value is drawn from it to seed generation in
+// place of config.Seed, so sources in the same state yield the same terrain
 func GenerateTerrain(grid *hex.Grid, config TerrainConfig) ([]*HexTile, error) {
+	heightmap, err := terrainHeightmap(grid, config)
+	if err != nil {
+		return nil, err
+	}
+	
+	// Convert heightmap to hex tiles with proper coordinate mapping
+	tiles := HeightmapToHexTiles(heightmap, grid, config.SeaLevel)
+	
+	return tiles, nil
+}
+
+// GenerateTerrainFromHeightmap builds terrain from an existing heightfield, such
+// as an imported DEM, instead of generating noise. heightmap should be
+// normalized to [0, 1]; it is resized to cover the grid, then shaped by the
+// hypsometric curve and classified like generated terrain. The noise and
+// falloff settings in config are ignored
+func GenerateTerrainFromHeightmap(grid *hex.Grid, heightmap [][]float64, config TerrainConfig) ([]*HexTile, error) {
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
+	if len(heightmap) == 0 || len(heightmap[0]) == 0 {
+		return nil, &TerrainError{"empty heightmap provided"}
+	}
 	
-	// Get grid dimensions for heightmap generation
-	coords := grid.AllCoords()
-	if len(coords) == 0 {
+	minCol, minRow, maxCol, maxRow := grid.Bounds()
+	if maxCol < minCol || maxRow < minRow {
 		return nil, &TerrainError{"empty grid provided"}
 	}
 	
-	// Determine bounding box for heightmap
-	width, height := calculateGridDimensions(coords)
+	resized := resampleHeightmap(heightmap, maxCol-minCol+1, maxRow-minRow+1)
+	resized = ApplyHypsometricCurve(resized, config.LandRatio)
+	
+	return HeightmapToHexTiles(resized, grid, config.SeaLevel), nil
+}
+
+// terrainHeightmap generates the elevation heightmap covering a grid, in meters
+func terrainHeightmap(grid *hex.Grid, config TerrainConfig) ([][]float64, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	
+	// Size the heightmap to the grid's offset bounding box
+	minCol, minRow, maxCol, maxRow := grid.Bounds()
+	if maxCol < minCol || maxRow < minRow {
+		return nil, &TerrainError{"empty grid provided"}
+	}
+	width, height := maxCol-minCol+1, maxRow-minRow+1
 	
 	// Generate base heightmap using multi-octave noise
+	config = config.resolveRand()
 	heightmap := GenerateHeightmap(width, height, config.NoiseParams, config.Seed)
 	
+	// Shape where land can form before fixing the land ratio
+	ApplyFalloff(heightmap, config.Falloff, config.FalloffStrength, config.Seed)
+	
 	// Apply hypsometric curve to match Earth's elevation distribution
 	heightmap = ApplyHypsometricCurve(heightmap, config.LandRatio)
 	
-	// Convert heightmap to hex tiles with proper coordinate mapping
-	tiles := HeightmapToHexTiles(heightmap, grid, config.SeaLevel)
-	
-	return tiles, nil
+	return heightmap, nil
 }
 
-// GenerateHeightmap creates a fractal heightmap using Diamond-Square algorithm
+// GenerateHeightmap creates a fractal heightmap using the configured noise type
+// (Diamond-Square by default). With params.Tileable the heightmap wraps
+// seamlessly left-to-right and top-to-bottom
 func GenerateHeightmap(width, height int, params NoiseParameters, seed int64) [][]float64 {
+	if params.Tileable {
+		switch params.Type {
+		case NoisePerlin:
+			// Perlin wraps exactly by tiling its gradient lattice
+			return noise.FractalNoise(width, height, params.Octaves,
+				params.Persistence, params.Lacunarity, params.Scale, seed, noise.TileablePerlinNoise)
+		case NoiseDiamondSquare:
+			// Diamond-Square octaves wrap exactly on their periodic lattice
+			return noise.TileableMultiOctaveNoise(width, height, params.Octaves,
+				params.Persistence, params.Lacunarity, params.Scale, seed)
+		}
+		return noise.MakeTileable(width, height, func(w, h int) [][]float64 {
+			return generateNoise(w, h, params, seed)
+		})
+	}
+	
+	return generateNoise(width, height, params, seed)
+}
+
+// generateNoise dispatches to the configured noise algorithm
+func generateNoise(width, height int, params NoiseParameters, seed int64) [][]float64 {
+	switch params.Type {
+	case NoisePerlin:
+		return noise.FractalNoise(width, height, params.Octaves,
+			params.Persistence, params.Lacunarity, params.Scale, seed, noise.PerlinNoise)
+	case NoiseSimplex:
+		return noise.FractalNoise(width, height, params.Octaves,
+			params.Persistence, params.Lacunarity, params.Scale, seed, noise.SimplexNoise)
+	}
+	
+	if params.Parallel {
+		return noise.MultiOctaveNoiseParallel(width, height, params.Octaves,
+			params.Persistence, params.Lacunarity, params.Scale, seed)
+	}
 	return noise.MultiOctaveNoise(width, height, params.Octaves, 
 		params.Persistence, params.Lacunarity, params.Scale, seed)
 }
@@ -76,10 +152,11 @@ func ApplyHypsometricCurve(heightmap [][]float64, targetLandRatio float64) [][]f
 			originalElev := result[y][x]
 			
 			if originalElev <= seaLevelThreshold {
-				// Ocean depths: apply cubic curve for deep ocean basins
-				ratio := originalElev / seaLevelThreshold
-				if ratio < 0 {
-					ratio = 0
+				// Ocean depths: apply cubic curve for deep ocean basins,
+				// measured from the sea level threshold down to the lowest value
+				ratio := 0.0
+				if seaLevelThreshold > elevations[0] {
+					ratio = (seaLevelThreshold - originalElev) / (seaLevelThreshold - elevations[0])
 				}
 				depth := math.Pow(ratio, 3) * 6000 // Max depth ~6000m
 				result[y][x] = -depth
@@ -99,78 +176,115 @@ func ApplyHypsometricCurve(heightmap [][]float64, targetLandRatio float64) [][]f
 	return result
 }
 
-// HeightmapToHexTiles converts a heightmap to hex tiles with land/water classification
+// HeightmapToHexTiles converts a heightmap to hex tiles with land/water classification.
+// Tiles are built by a worker pool sized to GOMAXPROCS; output is in AllCoords
+// order and identical to a serial conversion
 func HeightmapToHexTiles(heightmap [][]float64, grid *hex.Grid, seaLevel float64) []*HexTile {
+	return heightmapToHexTiles(heightmap, grid, seaLevel, runtime.GOMAXPROCS(0))
+}
+
+// minTilesPerWorker keeps small grids from paying goroutine overhead
+const minTilesPerWorker = 4096
+
+// heightmapToHexTiles converts a heightmap using up to workers goroutines, each
+// filling a disjoint contiguous range of the output
+func heightmapToHexTiles(heightmap [][]float64, grid *hex.Grid, seaLevel float64, workers int) []*HexTile {
 	coords := grid.AllCoords()
 	tiles := make([]*HexTile, len(coords))
 	
+	workers = min(workers, (len(coords)+minTilesPerWorker-1)/minTilesPerWorker)
+	if workers <= 1 {
+		fillHexTiles(heightmap, coords, tiles, seaLevel)
+		return tiles
+	}
+	
+	chunk := (len(coords) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(coords); start += chunk {
+		end := min(start+chunk, len(coords))
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			fillHexTiles(heightmap, coords[start:end], tiles[start:end], seaLevel)
+		}(start, end)
+	}
+	wg.Wait()
+	
+	return tiles
+}
+
+// fillHexTiles builds the tile for each coordinate from the heightmap
+func fillHexTiles(heightmap [][]float64, coords []hex.AxialCoord, tiles []*HexTile, seaLevel float64) {
+	for i, coord := range coords {
+		tiles[i] = heightmapTile(heightmap, coord, seaLevel)
+	}
+}
+
+// heightmapTile builds a single classified tile from the heightmap
+func heightmapTile(heightmap [][]float64, coord hex.AxialCoord, seaLevel float64) *HexTile {
 	height := len(heightmap)
 	width := 0
 	if height > 0 {
 		width = len(heightmap[0])
 	}
 	
-	for i, coord := range coords {
-		// Map hex coordinate to heightmap indices
-		col, row := coord.ToOffset()
-		
-		// Ensure we're within heightmap bounds
-		x := col % width
-		y := row % height
-		if x < 0 {
-			x += width
-		}
-		if y < 0 {
-			y += height
-		}
-		
-		elevation := heightmap[y][x]
-		
-		tile := &HexTile{
-			Coordinates:     coord,
-			Elevation:       elevation,
-			DistanceToWater: 0, // Will be calculated later
-		}
-		
-		// Classify as land or water based on sea level
-		tile.ClassifyLandWater(seaLevel)
-		
-		tiles[i] = tile
+	// Map hex coordinate to heightmap indices
+	col, row := coord.ToOffset()
+	
+	// Ensure we're within heightmap bounds
+	x := col % width
+	y := row % height
+	if x < 0 {
+		x += width
+	}
+	if y < 0 {
+		y += height
 	}
 	
-	return tiles
+	tile := &HexTile{
+		Coordinates:     coord,
+		Elevation:       heightmap[y][x],
+		DistanceToWater: 0, // Will be calculated later
+	}
+	
+	// Classify as land or water based on sea level
+	tile.ClassifyLandWater(seaLevel)
+	
+	return tile
 }
 
-// calculateGridDimensions determines the bounding box for a set of coordinates
-func calculateGridDimensions(coords []hex.AxialCoord) (width, height int) {
-	if len(coords) == 0 {
-		return 0, 0
+// HexTilesToHeightmap lays tile elevations out on the grid's offset bounding
+// box, the inverse of HeightmapToHexTiles. Cells with no tile, such as the
+// corners around a hexagon-shaped grid, take the lowest tile elevation
+func HexTilesToHeightmap(tiles []*HexTile, grid *hex.Grid) [][]float64 {
+	minCol, minRow, maxCol, maxRow := grid.Bounds()
+	if maxCol < minCol || maxRow < minRow {
+		return nil
 	}
 	
-	minCol, maxCol := math.MaxInt32, math.MinInt32
-	minRow, maxRow := math.MaxInt32, math.MinInt32
-	
-	for _, coord := range coords {
-		col, row := coord.ToOffset()
-		
-		if col < minCol {
-			minCol = col
-		}
-		if col > maxCol {
-			maxCol = col
-		}
-		if row < minRow {
-			minRow = row
+	floor := 0.0
+	for i, tile := range tiles {
+		if i == 0 || tile.Elevation < floor {
+			floor = tile.Elevation
 		}
-		if row > maxRow {
-			maxRow = row
+	}
+	
+	heightmap := make([][]float64, maxRow-minRow+1)
+	for y := range heightmap {
+		heightmap[y] = make([]float64, maxCol-minCol+1)
+		for x := range heightmap[y] {
+			heightmap[y][x] = floor
 		}
 	}
 	
-	width = maxCol - minCol + 1
-	height = maxRow - minRow + 1
+	for _, tile := range tiles {
+		col, row := grid.WrapCoord(tile.Coordinates).ToOffset()
+		if row >= minRow && row <= maxRow && col >= minCol && col <= maxCol {
+			heightmap[row-minRow][col-minCol] = tile.Elevation
+		}
+	}
 	
-	return width, height
+	return heightmap
 }
 
 // ElevationToRealisticRange scales normalized elevation [-1,1] to Earth-like range
@@ -231,4 +345,26 @@ func ScaleElevationRange(tiles []*HexTile, minElev, maxElev float64) {
 		// Reclassify land/water after scaling
 		tile.ClassifyLandWater(0.0) // Assume sea level is 0
 	}
-}
\ No newline at end of file
+}
+
+// QuantizeElevation snaps every elevation to the nearest multiple of stepMeters,
+// producing flat terraces. Elevations never cross sea level (0): low land rises
+// to the first terrace instead of flooding, so the land ratio is kept. Tiles
+// are then reclassified. A step of 0 or less leaves tiles unchanged
+func QuantizeElevation(tiles []*HexTile, stepMeters float64) {
+	if stepMeters <= 0 {
+		return
+	}
+	
+	for _, tile := range tiles {
+		snapped := math.Round(tile.Elevation/stepMeters) * stepMeters
+		if tile.Elevation > SeaLevelDefault {
+			snapped = math.Max(snapped, stepMeters)
+		} else if snapped >= SeaLevelDefault {
+			snapped = SeaLevelDefault // Also avoids -0 from rounding small depths
+		}
+		
+		tile.Elevation = snapped
+		tile.ClassifyLandWater(SeaLevelDefault)
+	}
+}
@@ -1,6 +1,10 @@
 package terrain
 
 import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/sean/hex-map/pkg/hex"
@@ -116,6 +120,104 @@ func TestGenerateHeightmap(t *testing.T) {
 			}
 		}
 	}
+	
+	// Parallel generation must match serial generation exactly
+	params.Parallel = true
+	parallel := GenerateHeightmap(width, height, params, seed)
+	
+	for y := range heightmap {
+		for x := range heightmap[y] {
+			if heightmap[y][x] != parallel[y][x] {
+				t.Errorf("Parallel generation differs at (%d,%d): %f vs %f",
+					x, y, heightmap[y][x], parallel[y][x])
+			}
+		}
+	}
+}
+
+func TestGenerateHeightmapNoiseTypes(t *testing.T) {
+	width, height := 20, 16
+	seed := int64(42)
+	
+	diamond := DefaultNoiseParameters()
+	perlin := DefaultNoiseParameters()
+	perlin.Type = NoisePerlin
+	perlin.Scale = 0.1
+	
+	perlinMap := GenerateHeightmap(width, height, perlin, seed)
+	diamondMap := GenerateHeightmap(width, height, diamond, seed)
+	
+	if len(perlinMap) != height || len(perlinMap[0]) != width {
+		t.Fatalf("Expected %dx%d Perlin heightmap, got %dx%d", width, height, len(perlinMap[0]), len(perlinMap))
+	}
+	
+	different := false
+	for y := range perlinMap {
+		for x := range perlinMap[y] {
+			if perlinMap[y][x] < -1.0 || perlinMap[y][x] > 1.0 {
+				t.Errorf("Perlin value out of range at (%d,%d): %f", x, y, perlinMap[y][x])
+			}
+			if perlinMap[y][x] != diamondMap[y][x] {
+				different = true
+			}
+		}
+	}
+	
+	if !different {
+		t.Error("Noise type selector had no effect on the heightmap")
+	}
+	
+	simplex := perlin
+	simplex.Type = NoiseSimplex
+	simplexMap := GenerateHeightmap(width, height, simplex, seed)
+	if simplexMap[height/2][width/2] == perlinMap[height/2][width/2] &&
+		simplexMap[0][0] == perlinMap[0][0] {
+		t.Error("Simplex noise type produced the same heightmap as Perlin")
+	}
+	
+	// Unknown noise types are rejected
+	config := DefaultTerrainConfig()
+	config.NoiseParams.Type = NoiseType(99)
+	if err := config.Validate(); err == nil {
+		t.Error("Expected validation error for unknown noise type")
+	}
+}
+
+func TestGenerateHeightmapTileable(t *testing.T) {
+	width, height := 48, 32
+	
+	for _, noiseType := range []NoiseType{NoiseDiamondSquare, NoisePerlin, NoiseSimplex} {
+		params := DefaultNoiseParameters()
+		params.Type = noiseType
+		params.Scale = 0.1
+		params.Tileable = true
+		
+		heightmap := GenerateHeightmap(width, height, params, 42)
+		if len(heightmap) != height || len(heightmap[0]) != width {
+			t.Fatalf("Type %d: expected %dx%d heightmap, got %dx%d",
+				noiseType, width, height, len(heightmap[0]), len(heightmap))
+		}
+		
+		// Edges should differ from their wrapped counterparts no more than
+		// ordinary neighbors do
+		seam, interior := 0.0, 0.0
+		for y := 0; y < height; y++ {
+			seam = math.Max(seam, math.Abs(heightmap[y][width-1]-heightmap[y][0]))
+			for x := 0; x+1 < width; x++ {
+				interior = math.Max(interior, math.Abs(heightmap[y][x+1]-heightmap[y][x]))
+			}
+		}
+		for x := 0; x < width; x++ {
+			seam = math.Max(seam, math.Abs(heightmap[height-1][x]-heightmap[0][x]))
+			for y := 0; y+1 < height; y++ {
+				interior = math.Max(interior, math.Abs(heightmap[y+1][x]-heightmap[y][x]))
+			}
+		}
+		
+		if seam > interior {
+			t.Errorf("Type %d: seam delta %f exceeds interior neighbor delta %f", noiseType, seam, interior)
+		}
+	}
 }
 
 func TestApplyHypsometricCurve(t *testing.T) {
@@ -195,55 +297,6 @@ func TestHeightmapToHexTiles(t *testing.T) {
 	}
 }
 
-func TestCalculateGridDimensions(t *testing.T) {
-	tests := []struct {
-		name     string
-		coords   []hex.AxialCoord
-		expWidth int
-		expHeight int
-	}{
-		{
-			name:      "empty coords",
-			coords:    []hex.AxialCoord{},
-			expWidth:  0,
-			expHeight: 0,
-		},
-		{
-			name: "single coordinate",
-			coords: []hex.AxialCoord{
-				hex.NewAxialCoord(0, 0),
-			},
-			expWidth:  1,
-			expHeight: 1,
-		},
-		{
-			name: "rectangular area",
-			coords: []hex.AxialCoord{
-				hex.NewAxialCoord(0, 0),   // offset (0,0)
-				hex.NewAxialCoord(1, 0),   // offset (1,0)
-				hex.NewAxialCoord(0, 1),   // offset (0,1)
-				hex.NewAxialCoord(1, 1),   // offset (1,1)
-			},
-			expWidth:  2,
-			expHeight: 3, // Due to hex coordinate system offset conversion
-		},
-	}
-	
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			width, height := calculateGridDimensions(tt.coords)
-			
-			if width != tt.expWidth {
-				t.Errorf("calculateGridDimensions() width = %d, want %d", width, tt.expWidth)
-			}
-			
-			if height != tt.expHeight {
-				t.Errorf("calculateGridDimensions() height = %d, want %d", height, tt.expHeight)
-			}
-		})
-	}
-}
-
 func TestElevationToRealisticRange(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -399,4 +452,213 @@ func TestScaleElevationRange(t *testing.T) {
 	if actualMax < maxElev-tolerance || actualMax > maxElev+tolerance {
 		t.Errorf("Maximum elevation not used: got %f, expected ~%f", actualMax, maxElev)
 	}
-}
\ No newline at end of file
+}
+
+func TestHeightmapToHexTilesParallelMatchesSerial(t *testing.T) {
+	width, height := 200, 120
+	grid := hex.NewGrid(hex.GridConfig{Width: width, Height: height, Topology: hex.TopologyWorld})
+	heightmap := GenerateHeightmap(width, height, DefaultNoiseParameters(), 42)
+	heightmap = ApplyHypsometricCurve(heightmap, 0.29)
+	
+	serial := heightmapToHexTiles(heightmap, grid, 0, 1)
+	parallel := heightmapToHexTiles(heightmap, grid, 0, 4)
+	
+	serialJSON, err := json.Marshal(serial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parallelJSON, err := json.Marshal(parallel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(serialJSON, parallelJSON) {
+		t.Error("Parallel conversion differs from serial conversion")
+	}
+	
+	// Tiles stay in AllCoords order
+	for i, coord := range grid.AllCoords() {
+		if parallel[i].Coordinates != coord {
+			t.Fatalf("Tile %d is %v, expected %v", i, parallel[i].Coordinates, coord)
+		}
+	}
+}
+
+func BenchmarkHeightmapToHexTiles(b *testing.B) {
+	width, height := 500, 500
+	grid := hex.NewGrid(hex.GridConfig{Width: width, Height: height, Topology: hex.TopologyRegion})
+	heightmap := GenerateHeightmap(width, height, DefaultNoiseParameters(), 42)
+	
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			heightmapToHexTiles(heightmap, grid, 0, 1)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			HeightmapToHexTiles(heightmap, grid, 0)
+		}
+	})
+}
+
+func TestQuantizeElevation(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 40, Height: 30, Topology: hex.TopologyRegion})
+	tiles, err := TerrainFromGridWithSeed(grid, 21)
+	if err != nil {
+		t.Fatalf("Failed to generate terrain: %v", err)
+	}
+	landBefore := ValidateTerrain(tiles).LandPercentage
+	
+	const step = 100.0
+	QuantizeElevation(tiles, step)
+	
+	for _, tile := range tiles {
+		if steps := tile.Elevation / step; steps != math.Trunc(steps) {
+			t.Fatalf("Tile %v elevation %f is not a multiple of %f", tile.Coordinates, tile.Elevation, step)
+		}
+		if tile.IsLand != (tile.Elevation > 0) {
+			t.Errorf("Tile %v at %f not reclassified", tile.Coordinates, tile.Elevation)
+		}
+	}
+	
+	landAfter := ValidateTerrain(tiles).LandPercentage
+	if math.Abs(landAfter-landBefore) > 1 {
+		t.Errorf("Land changed from %.1f%% to %.1f%%", landBefore, landAfter)
+	}
+	
+	// Low land rises to the first terrace rather than flooding
+	shore := []*HexTile{{Elevation: 20}, {Elevation: -20}}
+	QuantizeElevation(shore, step)
+	if shore[0].Elevation != step || !shore[0].IsLand || shore[1].Elevation != 0 || shore[1].IsLand {
+		t.Errorf("Expected shore tiles at %f (land) and 0 (water), got %+v and %+v", step, *shore[0], *shore[1])
+	}
+	
+	// A non-positive step is a no-op
+	tile := &HexTile{Elevation: 123.4}
+	QuantizeElevation([]*HexTile{tile}, 0)
+	if tile.Elevation != 123.4 {
+		t.Errorf("Expected step 0 to leave elevation unchanged, got %f", tile.Elevation)
+	}
+}
+
+func TestHexTilesToHeightmap(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 9, Topology: hex.TopologyWorld})
+	tiles, err := TerrainFromGridWithSeed(grid, 8)
+	if err != nil {
+		t.Fatalf("Failed to generate terrain: %v", err)
+	}
+	
+	heightmap := HexTilesToHeightmap(tiles, grid)
+	if len(heightmap) != 9 || len(heightmap[0]) != 12 {
+		t.Fatalf("Expected a 12x9 heightmap, got %dx%d", len(heightmap[0]), len(heightmap))
+	}
+	
+	// Converting back gives the same tiles
+	for i, tile := range HeightmapToHexTiles(heightmap, grid, 0) {
+		if tile.Coordinates != tiles[i].Coordinates || tile.Elevation != tiles[i].Elevation {
+			t.Errorf("Round trip changed tile %v: %f -> %f", tiles[i].Coordinates, tiles[i].Elevation, tile.Elevation)
+		}
+	}
+	
+	// Cells outside a hexagon grid are filled with the lowest elevation
+	hexagon := hex.NewGrid(hex.GridConfig{Width: 5, Shape: hex.ShapeHexagon})
+	var shaped []*HexTile
+	for i, coord := range hexagon.AllCoords() {
+		shaped = append(shaped, &HexTile{Coordinates: coord, Elevation: float64(i + 10)})
+	}
+	heightmap = HexTilesToHeightmap(shaped, hexagon)
+	if heightmap[0][0] != 10 {
+		t.Errorf("Expected the empty corner to hold the lowest elevation 10, got %f", heightmap[0][0])
+	}
+}
+
+func TestGenerateTerrainFromHeightmap(t *testing.T) {
+	// An east-rising ramp at half the grid's resolution
+	ramp := make([][]float64, 8)
+	for y := range ramp {
+		ramp[y] = make([]float64, 16)
+		for x := range ramp[y] {
+			ramp[y][x] = float64(x) / 15
+		}
+	}
+	
+	grid := hex.NewGrid(hex.GridConfig{Width: 32, Height: 16, Topology: hex.TopologyRegion})
+	tiles, err := GenerateTerrainFromHeightmap(grid, ramp, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrainFromHeightmap failed: %v", err)
+	}
+	if len(tiles) != 32*16 {
+		t.Fatalf("Expected %d tiles, got %d", 32*16, len(tiles))
+	}
+	
+	// The imported ordering survives: elevation never drops going east, and
+	// land sits on the high side
+	byCoord := indexTiles(tiles)
+	for _, tile := range tiles {
+		col, row := tile.Coordinates.ToOffset()
+		if col == 31 {
+			continue
+		}
+		east := byCoord[hex.OffsetToAxial(col+1, row)]
+		if east.Elevation < tile.Elevation {
+			t.Errorf("Elevation drops from %f at %v to %f east of it", tile.Elevation, tile.Coordinates, east.Elevation)
+		}
+		if tile.IsLand && !east.IsLand {
+			t.Errorf("Land at %v has water to its east", tile.Coordinates)
+		}
+	}
+	
+	if _, err := GenerateTerrainFromHeightmap(grid, nil, DefaultTerrainConfig()); err == nil {
+		t.Error("Expected an error for an empty heightmap")
+	}
+}
+
+func TestGenerateTerrainWithRand(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 20, Height: 15, Topology: hex.TopologyRegion})
+
+	generate := func(source Rand) []*HexTile {
+		config := DefaultTerrainConfig()
+		config.Rand = source
+		tiles, err := GenerateTerrain(grid, config)
+		if err != nil {
+			t.Fatalf("GenerateTerrain failed: %v", err)
+		}
+		return tiles
+	}
+
+	// Sources in the same state produce the same terrain
+	first := generate(rand.New(rand.NewSource(7)))
+	second := generate(rand.New(rand.NewSource(7)))
+	for i := range first {
+		if *first[i] != *second[i] {
+			t.Fatalf("Same source state produced different tile %d: %+v vs %+v", i, *first[i], *second[i])
+		}
+	}
+
+	// Generation draws from the source, so reusing it continues the sequence
+	shared := rand.New(rand.NewSource(7))
+	generate(shared)
+	next := generate(shared)
+	same := true
+	for i := range first {
+		if first[i].Elevation != next[i].Elevation {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Error("Expected a reused source to produce new terrain")
+	}
+
+	// The drawn value acts as the seed, and a nil source falls back to Seed
+	config := DefaultTerrainConfig()
+	config.Seed = rand.New(rand.NewSource(7)).Int63()
+	seeded, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+	for i := range first {
+		if *first[i] != *seeded[i] {
+			t.Fatalf("Drawn seed didn't match Seed at tile %d: %+v vs %+v", i, *first[i], *seeded[i])
+		}
+	}
+}
@@ -9,7 +9,7 @@ type HexTile struct {
 	Coordinates     hex.AxialCoord `json:"coordinates"`
 	Elevation       float64        `json:"elevation"`        // meters above sea level
 	IsLand         bool           `json:"is_land"`          // land vs water classification
-	DistanceToWater float64        `json:"distance_to_water"` // km to nearest water (future use)
+	DistanceToWater float64        `json:"distance_to_water"` // distance to nearest water (see ComputeDistanceToWater)
 }
 
 // TerrainConfig contains all parameters for terrain generation
@@ -18,15 +18,59 @@ type TerrainConfig struct {
 	SeaLevel    float64         `json:"sea_level"`    // Elevation threshold for land/water
 	LandRatio   float64         `json:"land_ratio"`   // Target percentage of land tiles
 	NoiseParams NoiseParameters `json:"noise_params"` // Multi-octave noise configuration
+	
+	Falloff         FalloffShape `json:"falloff"`          // Edge mask applied before hypsometric scaling
+	FalloffStrength float64      `json:"falloff_strength"` // Steepness of the edge mask (1 is typical)
+	
+	Rand Rand `json:"-"` // Optional random source drawn from instead of Seed
+}
+
+// Rand supplies randomness for terrain generation. *rand.Rand and rand.Source
+// both satisfy it, so callers can inject deterministic or cryptographic sources
+type Rand interface {
+	Int63() int64
+}
+
+// resolveRand draws the generation seed from Rand when it is set, returning a
+// config whose Seed reproduces the terrain without the source
+func (config TerrainConfig) resolveRand() TerrainConfig {
+	if config.Rand != nil {
+		config.Seed = config.Rand.Int63()
+		config.Rand = nil
+	}
+	return config
 }
 
+// NoiseType selects the base noise algorithm used for heightmap generation
+type NoiseType int
+
+const (
+	NoiseDiamondSquare NoiseType = iota // Diamond-Square midpoint displacement
+	NoisePerlin                         // Classic Perlin gradient noise
+	NoiseSimplex                        // Simplex noise with fewer directional artifacts
+)
+
+// FalloffShape selects the mask that lowers the heightmap toward the map edges,
+// controlling where land forms
+type FalloffShape int
+
+const (
+	FalloffNone       FalloffShape = iota // No mask; land can reach every edge
+	FalloffRadial                         // Round central island
+	FalloffSquareBump                     // Continent filling most of the map, water only near the edges
+	FalloffRidged                         // Archipelago of island chains within a radial mask
+)
+
 // NoiseParameters controls the fractal noise generation
 type NoiseParameters struct {
-	Octaves     int     `json:"octaves"`     // Number of noise octaves
-	Persistence float64 `json:"persistence"` // Amplitude reduction per octave
-	Lacunarity  float64 `json:"lacunarity"`  // Frequency increase per octave
-	Scale       float64 `json:"scale"`       // Initial noise scale
-	HurstExp    float64 `json:"hurst_exp"`   // Hurst exponent for fractal terrain
+	Type        NoiseType `json:"type"`        // Base noise algorithm
+	Octaves     int       `json:"octaves"`     // Number of noise octaves
+	Persistence float64   `json:"persistence"` // Amplitude reduction per octave
+	Lacunarity  float64   `json:"lacunarity"`  // Frequency increase per octave
+	Scale       float64   `json:"scale"`       // Initial noise frequency (Perlin and Simplex only; Diamond-Square spans the map)
+	HurstExp    float64   `json:"hurst_exp"`   // Hurst exponent for fractal terrain
+	Parallel    bool      `json:"parallel"`    // Generate octaves concurrently (identical output)
+	Tileable    bool      `json:"tileable"`    // Wrap seamlessly at the edges (for world topology)
 }
 
 // TerrainStats provides statistical analysis of generated terrain
@@ -40,6 +84,8 @@ type TerrainStats struct {
 	TotalTiles       int        `json:"total_tiles"`        // Total number of tiles
 	LandTiles        int        `json:"land_tiles"`         // Number of land tiles
 	WaterTiles       int        `json:"water_tiles"`        // Number of water tiles
+	LandmassCount    int        `json:"landmass_count"`     // Number of connected landmasses
+	LargestLandmass  int        `json:"largest_landmass"`   // Tiles in the largest landmass
 }
 
 // DefaultTerrainConfig returns scientifically-based default parameters
@@ -75,6 +121,18 @@ func (tc TerrainConfig) Validate() error {
 		return &TerrainError{"land_ratio must be between 0.0 and 1.0"}
 	}
 	
+	if tc.Falloff < FalloffNone || tc.Falloff > FalloffRidged {
+		return &TerrainError{"unknown falloff shape"}
+	}
+	
+	if tc.FalloffStrength < 0.0 {
+		return &TerrainError{"falloff_strength must not be negative"}
+	}
+	
+	if tc.NoiseParams.Type < NoiseDiamondSquare || tc.NoiseParams.Type > NoiseSimplex {
+		return &TerrainError{"unknown noise type"}
+	}
+	
 	if tc.NoiseParams.Octaves < 1 || tc.NoiseParams.Octaves > 10 {
 		return &TerrainError{"octaves must be between 1 and 10"}
 	}
@@ -118,6 +176,19 @@ func (ht *HexTile) IsRealistic() bool {
 	return ht.Elevation >= ElevationMin && ht.Elevation <= ElevationMax
 }
 
+// NormalizedElevation maps elevation from [ElevationMin, ElevationMax] to [0, 1],
+// clamping values outside Earth's range
+func (ht *HexTile) NormalizedElevation() float64 {
+	normalized := (ht.Elevation - ElevationMin) / (ElevationMax - ElevationMin)
+	if normalized < 0.0 {
+		return 0.0
+	}
+	if normalized > 1.0 {
+		return 1.0
+	}
+	return normalized
+}
+
 // ClassifyLandWater determines if a tile is land or water based on sea level
 func (ht *HexTile) ClassifyLandWater(seaLevel float64) {
 	ht.IsLand = ht.Elevation > seaLevel
@@ -1,6 +1,7 @@
 package terrain
 
 import (
+	"math"
 	"testing"
 
 	"github.com/sean/hex-map/pkg/hex"
@@ -173,6 +174,33 @@ func TestHexTileRealism(t *testing.T) {
 	}
 }
 
+func TestNormalizedElevation(t *testing.T) {
+	seaLevelFraction := -ElevationMin / (ElevationMax - ElevationMin)
+	
+	tests := []struct {
+		elevation float64
+		expected  float64
+	}{
+		{ElevationMin, 0.0},
+		{ElevationMax, 1.0},
+		{0.0, seaLevelFraction},
+		{ElevationMin - 500, 0.0}, // Clamped below
+		{ElevationMax + 500, 1.0}, // Clamped above
+	}
+	
+	for _, tt := range tests {
+		tile := &HexTile{Elevation: tt.elevation}
+		if got := tile.NormalizedElevation(); math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("NormalizedElevation(%f) = %f, expected %f", tt.elevation, got, tt.expected)
+		}
+	}
+	
+	// Sea level sits a little over halfway up Earth's range
+	if seaLevelFraction < 0.55 || seaLevelFraction > 0.56 {
+		t.Errorf("Sea level fraction %f, expected about 0.554", seaLevelFraction)
+	}
+}
+
 func TestClassifyLandWater(t *testing.T) {
 	tests := []struct {
 		name      string
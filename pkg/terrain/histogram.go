@@ -0,0 +1,35 @@
+package terrain
+
+// ElevationHistogram divides the elevation range of tiles into equal-width bins
+// and counts the tiles in each. It returns bins+1 edges running from the
+// minimum to the maximum elevation, and bins counts; bin i covers
+// [edges[i], edges[i+1]), except the last bin, which also includes the maximum
+func ElevationHistogram(tiles []*HexTile, bins int) ([]float64, []int) {
+	if len(tiles) == 0 || bins < 1 {
+		return nil, nil
+	}
+
+	elevations := make([]float64, len(tiles))
+	for i, tile := range tiles {
+		elevations[i] = tile.Elevation
+	}
+	minElev, maxElev := findMinMaxFloat64(elevations)
+
+	width := (maxElev - minElev) / float64(bins)
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = minElev + float64(i)*width
+	}
+	edges[bins] = maxElev
+
+	counts := make([]int, bins)
+	for _, elev := range elevations {
+		bin := bins - 1
+		if width > 0 {
+			bin = min(int((elev-minElev)/width), bins-1)
+		}
+		counts[bin]++
+	}
+
+	return edges, counts
+}
@@ -0,0 +1,65 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestElevationHistogram(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 30, Height: 20})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+
+	bins := 12
+	edges, counts := ElevationHistogram(tiles, bins)
+
+	if len(edges) != bins+1 || len(counts) != bins {
+		t.Fatalf("Expected %d edges and %d counts, got %d and %d", bins+1, bins, len(edges), len(counts))
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	if total != len(tiles) {
+		t.Errorf("Bin counts sum to %d, expected %d", total, len(tiles))
+	}
+
+	stats := ValidateTerrain(tiles)
+	if edges[0] != stats.ElevationRange[0] || edges[bins] != stats.ElevationRange[1] {
+		t.Errorf("Edges span [%f, %f], expected [%f, %f]",
+			edges[0], edges[bins], stats.ElevationRange[0], stats.ElevationRange[1])
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			t.Errorf("Edges not increasing at %d: %f <= %f", i, edges[i], edges[i-1])
+		}
+	}
+}
+
+func TestElevationHistogramEdgeCases(t *testing.T) {
+	if edges, counts := ElevationHistogram(nil, 10); edges != nil || counts != nil {
+		t.Error("Expected nil histogram for no tiles")
+	}
+
+	tiles := []*HexTile{{Elevation: 5}, {Elevation: 5}, {Elevation: 5}}
+	if edges, counts := ElevationHistogram(tiles, 0); edges != nil || counts != nil {
+		t.Error("Expected nil histogram for zero bins")
+	}
+
+	// Flat terrain lands entirely in one bin
+	_, counts := ElevationHistogram(tiles, 4)
+	if counts[3] != 3 {
+		t.Errorf("Expected all tiles in the last bin, got %v", counts)
+	}
+
+	// The maximum falls in the last bin rather than past it
+	tiles = []*HexTile{{Elevation: 0}, {Elevation: 10}, {Elevation: 20}}
+	_, counts = ElevationHistogram(tiles, 2)
+	if counts[0] != 1 || counts[1] != 2 {
+		t.Errorf("Expected counts [1 2], got %v", counts)
+	}
+}
@@ -0,0 +1,98 @@
+package terrain
+
+import (
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// WaterLandmassID is the landmass ID assigned to water tiles
+const WaterLandmassID = -1
+
+// LabelLandmasses assigns an integer ID to each connected component of land tiles.
+// IDs are numbered from 0 in the order their first tile appears in tiles, so
+// labeling is deterministic for a given input ordering. Water tiles are
+// labeled WaterLandmassID
+func LabelLandmasses(tiles []*HexTile, grid *hex.Grid) map[hex.AxialCoord]int {
+	tileMap := indexTiles(tiles)
+	labels := make(map[hex.AxialCoord]int, len(tiles))
+
+	isLand := func(c hex.AxialCoord) bool {
+		tile, ok := tileMap[c]
+		return ok && tile.IsLand
+	}
+
+	nextID := 0
+	for _, tile := range tiles {
+		if _, labeled := labels[tile.Coordinates]; labeled {
+			continue
+		}
+
+		if !tile.IsLand {
+			labels[tile.Coordinates] = WaterLandmassID
+			continue
+		}
+
+		labels[tile.Coordinates] = nextID
+		for _, coord := range grid.FloodFill(tile.Coordinates, isLand) {
+			labels[coord] = nextID
+		}
+		nextID++
+	}
+
+	return labels
+}
+
+// LandmassSizes returns the number of tiles in each landmass, indexed by ID
+func LandmassSizes(labels map[hex.AxialCoord]int) []int {
+	var sizes []int
+	for _, id := range labels {
+		if id == WaterLandmassID {
+			continue
+		}
+		for len(sizes) <= id {
+			sizes = append(sizes, 0)
+		}
+		sizes[id]++
+	}
+	return sizes
+}
+
+// landmassStats counts landmasses and the size of the largest one. Since tiles
+// carry no grid, connectivity is computed on a bounded grid covering their
+// offset coordinates, so landmasses are not joined across world wrap seams
+func landmassStats(tiles []*HexTile) (count, largest int) {
+	grid := tileBoundsGrid(tiles)
+	sizes := LandmassSizes(LabelLandmasses(tiles, grid))
+
+	for _, size := range sizes {
+		if size > largest {
+			largest = size
+		}
+	}
+
+	return len(sizes), largest
+}
+
+// tileBoundsGrid returns the smallest region grid containing every tile
+func tileBoundsGrid(tiles []*HexTile) *hex.Grid {
+	maxCol, maxRow := 0, 0
+	for _, tile := range tiles {
+		col, row := tile.Coordinates.ToOffset()
+		if col > maxCol {
+			maxCol = col
+		}
+		if row > maxRow {
+			maxRow = row
+		}
+	}
+
+	return hex.NewGrid(hex.GridConfig{Width: maxCol + 1, Height: maxRow + 1, Topology: hex.TopologyRegion})
+}
+
+// indexTiles builds a coordinate lookup table for a set of tiles
+func indexTiles(tiles []*HexTile) map[hex.AxialCoord]*HexTile {
+	tileMap := make(map[hex.AxialCoord]*HexTile, len(tiles))
+	for _, tile := range tiles {
+		tileMap[tile.Coordinates] = tile
+	}
+	return tileMap
+}
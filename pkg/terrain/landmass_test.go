@@ -0,0 +1,85 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// elevationTiles creates one tile per grid hex with the elevation fn gives
+// its offset coordinates, classified against a sea level of 0
+func elevationTiles(grid *hex.Grid, fn func(col, row int) float64) []*HexTile {
+	coords := grid.AllCoords()
+	tiles := make([]*HexTile, len(coords))
+
+	for i, coord := range coords {
+		col, row := coord.ToOffset()
+		tiles[i] = &HexTile{Coordinates: coord, Elevation: fn(col, row)}
+		tiles[i].ClassifyLandWater(0.0)
+	}
+
+	return tiles
+}
+
+// buildTiles creates one tile per grid hex, marking land where isLand is true
+func buildTiles(grid *hex.Grid, isLand func(col, row int) bool) []*HexTile {
+	return elevationTiles(grid, func(col, row int) float64 {
+		if isLand(col, row) {
+			return 100.0
+		}
+		return -100.0
+	})
+}
+
+func TestLabelLandmasses(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 8, Height: 6, Topology: hex.TopologyRegion})
+
+	// Offset (2,2) and (3,1) touch diagonally in the offset grid but are not
+	// hex neighbors in the even-q layout
+	tiles := buildTiles(grid, func(col, row int) bool {
+		return (col == 2 && row >= 2) || (col == 3 && row <= 1)
+	})
+
+	labels := LabelLandmasses(tiles, grid)
+
+	if len(labels) != len(tiles) {
+		t.Errorf("Expected a label for every tile, got %d of %d", len(labels), len(tiles))
+	}
+
+	southID := labels[hex.OffsetToAxial(2, 2)]
+	northID := labels[hex.OffsetToAxial(3, 1)]
+
+	if southID == WaterLandmassID || northID == WaterLandmassID {
+		t.Fatalf("Land tiles labeled as water: south=%d north=%d", southID, northID)
+	}
+	if southID == northID {
+		t.Errorf("Diagonally touching blobs should get different IDs, both got %d", southID)
+	}
+
+	if id := labels[hex.OffsetToAxial(0, 0)]; id != WaterLandmassID {
+		t.Errorf("Expected water tile to be labeled %d, got %d", WaterLandmassID, id)
+	}
+
+	// Every tile in a blob shares its ID
+	for row := 2; row < 6; row++ {
+		if id := labels[hex.OffsetToAxial(2, row)]; id != southID {
+			t.Errorf("Tile (2,%d) has ID %d, expected %d", row, id, southID)
+		}
+	}
+
+	sizes := LandmassSizes(labels)
+	if len(sizes) != 2 {
+		t.Fatalf("Expected 2 landmasses, got %d", len(sizes))
+	}
+	if sizes[southID] != 4 || sizes[northID] != 2 {
+		t.Errorf("Expected landmass sizes 4 and 2, got %d and %d", sizes[southID], sizes[northID])
+	}
+
+	// Labeling is deterministic for the same input
+	again := LabelLandmasses(tiles, grid)
+	for coord, id := range labels {
+		if again[coord] != id {
+			t.Errorf("Non-deterministic label at %v: %d vs %d", coord, id, again[coord])
+		}
+	}
+}
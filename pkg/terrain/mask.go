@@ -0,0 +1,95 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/sean/hex-map/internal/noise"
+)
+
+// ridgeScale is the number of ridged-noise lattice cells across the map
+// for FalloffRidged; higher values give more, smaller islands
+const ridgeScale = 6.0
+
+// ridgeSeedSalt keeps the archipelago ridges independent of base noise
+// generated from the same seed
+const ridgeSeedSalt = 0x2545f491
+
+// ApplyRadialMask lowers the heightmap toward its edges so land gathers in a
+// central continent and water at the borders. Each cell is scaled by
+// (1 - d²)^strength, where d is its distance from the center normalized so the
+// corners are at 1. Scaling is measured up from the heightmap's minimum, so
+// the mask works for noise in any range and masked cells sink to the lowest
+// existing elevation. Strength 0 leaves the heightmap unchanged; larger
+// values fall off more steeply. It modifies the heightmap in place and is
+// meant to run before ApplyHypsometricCurve
+func ApplyRadialMask(heightmap [][]float64, strength float64) {
+	ApplyFalloff(heightmap, FalloffRadial, strength, 0)
+}
+
+// ApplyFalloff applies an edge mask of the given shape in place, scaling as
+// described for ApplyRadialMask:
+//   - FalloffRadial scales by (1 - d²)^strength
+//   - FalloffSquareBump scales by ((1 - dx⁴)(1 - dy⁴))^strength, which stays
+//     high across most of the map and drops sharply near the edges
+//   - FalloffRidged scales the radial mask by the cube of ridged Perlin noise
+//     derived from seed, breaking the continent into island chains
+//
+// FalloffNone and strength 0 leave the heightmap unchanged
+func ApplyFalloff(heightmap [][]float64, shape FalloffShape, strength float64, seed int64) {
+	if shape == FalloffNone || strength <= 0 || len(heightmap) == 0 || len(heightmap[0]) == 0 {
+		return
+	}
+
+	height, width := len(heightmap), len(heightmap[0])
+	var ridges [][]float64
+	if shape == FalloffRidged {
+		scale := ridgeScale / float64(max(width, height))
+		ridges = noise.PerlinNoise(width, height, scale, seed^ridgeSeedSalt)
+	}
+
+	floor, _ := findMinMax(heightmap)
+	for y, row := range heightmap {
+		for x := range row {
+			var falloff float64
+			switch shape {
+			case FalloffSquareBump:
+				dx, dy := normalizedOffset(x, width), normalizedOffset(y, height)
+				falloff = (1 - dx*dx*dx*dx) * (1 - dy*dy*dy*dy)
+			case FalloffRidged:
+				d := normalizedRadius(x, y, width, height)
+				ridge := 1 - math.Abs(ridges[y][x])
+				falloff = (1 - d*d) * ridge * ridge * ridge
+			default:
+				d := normalizedRadius(x, y, width, height)
+				falloff = 1 - d*d
+			}
+			row[x] = floor + (row[x]-floor)*math.Pow(falloff, strength)
+		}
+	}
+}
+
+// normalizedRadius returns a cell's distance from the center of a width x height
+// field, scaled per axis so edge midpoints are at 1/√2 and corners at 1
+func normalizedRadius(x, y, width, height int) float64 {
+	return math.Hypot(normalizedOffset(x, width), normalizedOffset(y, height)) / math.Sqrt2
+}
+
+// normalizedOffset maps an index in [0, size) to [-1, 1] around the center
+func normalizedOffset(i, size int) float64 {
+	if size <= 1 {
+		return 0
+	}
+	return 2*float64(i)/float64(size-1) - 1
+}
+
+// findMinMax returns the lowest and highest values in a heightmap
+func findMinMax(heightmap [][]float64) (float64, float64) {
+	minVal, maxVal := math.Inf(1), math.Inf(-1)
+	for _, row := range heightmap {
+		for _, v := range row {
+			minVal = math.Min(minVal, v)
+			maxVal = math.Max(maxVal, v)
+		}
+	}
+	return minVal, maxVal
+}
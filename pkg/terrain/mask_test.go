@@ -0,0 +1,193 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// edgeAndCenterMeans averages the outermost ring of cells and the middle
+// third of a heightmap
+func edgeAndCenterMeans(heightmap [][]float64) (edge, center float64) {
+	height, width := len(heightmap), len(heightmap[0])
+	var edgeSum, centerSum float64
+	var edgeCount, centerCount int
+	for y, row := range heightmap {
+		for x, v := range row {
+			switch {
+			case x == 0 || y == 0 || x == width-1 || y == height-1:
+				edgeSum += v
+				edgeCount++
+			case x >= width/3 && x < 2*width/3 && y >= height/3 && y < 2*height/3:
+				centerSum += v
+				centerCount++
+			}
+		}
+	}
+	return edgeSum / float64(edgeCount), centerSum / float64(centerCount)
+}
+
+func TestApplyRadialMask(t *testing.T) {
+	params := DefaultNoiseParameters()
+	for _, noiseType := range []NoiseType{NoiseDiamondSquare, NoisePerlin} {
+		params.Type = noiseType
+		heightmap := GenerateHeightmap(48, 36, params, 3)
+		floor, _ := findMinMax(heightmap)
+
+		ApplyRadialMask(heightmap, 2)
+
+		edge, center := edgeAndCenterMeans(heightmap)
+		if edge >= center {
+			t.Errorf("Noise %d: edge mean %f should be below center mean %f", noiseType, edge, center)
+		}
+
+		// Corners sink all the way to the original minimum
+		if heightmap[0][0] != floor || heightmap[35][47] != floor {
+			t.Errorf("Noise %d: expected corners at %f, got %f and %f", noiseType, floor, heightmap[0][0], heightmap[35][47])
+		}
+	}
+}
+
+func TestApplyRadialMaskStrength(t *testing.T) {
+	original := GenerateHeightmap(32, 32, DefaultNoiseParameters(), 9)
+
+	unmasked := copyHeightmap(original)
+	ApplyRadialMask(unmasked, 0)
+	for y := range original {
+		for x := range original[y] {
+			if unmasked[y][x] != original[y][x] {
+				t.Fatalf("Strength 0 changed cell (%d,%d)", x, y)
+			}
+		}
+	}
+
+	// Stronger masks pull the edges further down
+	gentle := copyHeightmap(original)
+	ApplyRadialMask(gentle, 0.5)
+	steep := copyHeightmap(original)
+	ApplyRadialMask(steep, 4)
+
+	gentleEdge, _ := edgeAndCenterMeans(gentle)
+	steepEdge, _ := edgeAndCenterMeans(steep)
+	if steepEdge >= gentleEdge {
+		t.Errorf("Expected strength 4 edge mean %f below strength 0.5 edge mean %f", steepEdge, gentleEdge)
+	}
+}
+
+// falloffPattern summarizes where land forms on a generated map
+type falloffPattern struct {
+	land       map[hex.AxialCoord]bool
+	edgeLand   float64 // Fraction of the outer band that is land
+	centerLand float64 // Fraction of the middle third that is land
+	cornerLand float64 // Fraction of land in the four diagonal corner regions
+	landmasses int
+	largest    int
+}
+
+// measureFalloff generates terrain with a falloff shape and summarizes its land
+func measureFalloff(t *testing.T, grid *hex.Grid, shape FalloffShape) falloffPattern {
+	config := DefaultTerrainConfig()
+	config.Falloff = shape
+	config.FalloffStrength = 3
+	tiles, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("Shape %d: failed to generate terrain: %v", shape, err)
+	}
+
+	_, _, maxCol, maxRow := grid.Bounds()
+	width, height := maxCol+1, maxRow+1
+
+	pattern := falloffPattern{land: make(map[hex.AxialCoord]bool)}
+	var edge, edgeTotal, center, centerTotal, corner, land int
+	for _, tile := range tiles {
+		col, row := tile.Coordinates.ToOffset()
+		dx, dy := math.Abs(normalizedOffset(col, width)), math.Abs(normalizedOffset(row, height))
+		pattern.land[tile.Coordinates] = tile.IsLand
+
+		if dx > 0.8 || dy > 0.8 {
+			edgeTotal++
+			if tile.IsLand {
+				edge++
+			}
+		}
+		if dx < 1.0/3 && dy < 1.0/3 {
+			centerTotal++
+			if tile.IsLand {
+				center++
+			}
+		}
+		if tile.IsLand {
+			land++
+			if dx > 0.4 && dy > 0.4 {
+				corner++
+			}
+		}
+	}
+
+	pattern.edgeLand = float64(edge) / float64(edgeTotal)
+	pattern.centerLand = float64(center) / float64(centerTotal)
+	pattern.cornerLand = float64(corner) / float64(land)
+	pattern.landmasses, pattern.largest = landmassStats(tiles)
+	return pattern
+}
+
+func TestFalloffShapes(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 64, Height: 48, Topology: hex.TopologyRegion})
+
+	none := measureFalloff(t, grid, FalloffNone)
+	radial := measureFalloff(t, grid, FalloffRadial)
+	square := measureFalloff(t, grid, FalloffSquareBump)
+	ridged := measureFalloff(t, grid, FalloffRidged)
+
+	// Masks push land off the edges and into the middle
+	for name, masked := range map[string]falloffPattern{"radial": radial, "square bump": square, "ridged": ridged} {
+		if masked.edgeLand >= none.edgeLand {
+			t.Errorf("%s: edge land %.2f should be below unmasked %.2f", name, masked.edgeLand, none.edgeLand)
+		}
+		if masked.centerLand <= none.centerLand {
+			t.Errorf("%s: center land %.2f should exceed unmasked %.2f", name, masked.centerLand, none.centerLand)
+		}
+	}
+
+	// The square bump reaches further into the corners than the round island
+	if square.cornerLand <= radial.cornerLand {
+		t.Errorf("Square bump corner land %.2f should exceed radial %.2f", square.cornerLand, radial.cornerLand)
+	}
+
+	// The archipelago splits land into more, smaller pieces
+	if ridged.landmasses <= radial.landmasses || ridged.largest >= radial.largest {
+		t.Errorf("Ridged should have more, smaller landmasses than radial: %d (largest %d) vs %d (largest %d)",
+			ridged.landmasses, ridged.largest, radial.landmasses, radial.largest)
+	}
+
+	// Every pair of shapes places land differently
+	patterns := []falloffPattern{none, radial, square, ridged}
+	for i := range patterns {
+		for j := i + 1; j < len(patterns); j++ {
+			differ := 0
+			for coord, isLand := range patterns[i].land {
+				if patterns[j].land[coord] != isLand {
+					differ++
+				}
+			}
+			if differ < len(patterns[i].land)/50 {
+				t.Errorf("Shapes %d and %d differ on only %d tiles", i, j, differ)
+			}
+		}
+	}
+}
+
+func TestFalloffValidation(t *testing.T) {
+	config := DefaultTerrainConfig()
+	config.Falloff = FalloffShape(9)
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for an unknown falloff shape")
+	}
+
+	config.Falloff = FalloffRadial
+	config.FalloffStrength = -1
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for a negative falloff strength")
+	}
+}
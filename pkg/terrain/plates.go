@@ -0,0 +1,140 @@
+package terrain
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// plateCandidates is how many random hexes are weighed when placing each
+// plate center; more candidates spread centers more evenly
+const plateCandidates = 10
+
+// GeneratePlates divides the grid into numPlates tectonic plates, assigning
+// each hex the ID (0 to numPlates-1) of the nearest plate center by hex steps.
+// Centers are placed by best-candidate sampling, which keeps them well spread
+// so plates come out roughly balanced. Ties go to the lower plate ID.
+// numPlates is capped at the number of hexes; below 1 no plates are returned
+func GeneratePlates(grid *hex.Grid, numPlates int, seed int64) map[hex.AxialCoord]int {
+	coords := grid.AllCoords()
+	numPlates = min(numPlates, len(coords))
+	if numPlates < 1 {
+		return nil
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	centers := []hex.AxialCoord{coords[rng.Intn(len(coords))]}
+	for len(centers) < numPlates {
+		var best hex.AxialCoord
+		bestDist := -1
+		for i := 0; i < plateCandidates; i++ {
+			candidate := coords[rng.Intn(len(coords))]
+			nearest := -1
+			for _, center := range centers {
+				if d := candidate.DistanceTo(center, grid); nearest < 0 || d < nearest {
+					nearest = d
+				}
+			}
+			if nearest > bestDist {
+				best, bestDist = candidate, nearest
+			}
+		}
+		if bestDist == 0 {
+			// Every candidate was taken; fall back to the first free hex
+			best = firstFreeCoord(coords, centers)
+		}
+		centers = append(centers, best)
+	}
+
+	// Grow all plates at once, one ring per step, so each hex joins its nearest center
+	plates := make(map[hex.AxialCoord]int, len(coords))
+	queue := make([]hex.AxialCoord, 0, len(coords))
+	for id, center := range centers {
+		plates[center] = id
+		queue = append(queue, center)
+	}
+	for i := 0; i < len(queue); i++ {
+		current := queue[i]
+		for _, neighbor := range current.Neighbors(grid) {
+			if _, assigned := plates[neighbor]; !assigned {
+				plates[neighbor] = plates[current]
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return plates
+}
+
+// firstFreeCoord returns the first coordinate that is not already a center
+func firstFreeCoord(coords, centers []hex.AxialCoord) hex.AxialCoord {
+	taken := make(map[hex.AxialCoord]bool, len(centers))
+	for _, center := range centers {
+		taken[center] = true
+	}
+	for _, coord := range coords {
+		if !taken[coord] {
+			return coord
+		}
+	}
+	return coords[0]
+}
+
+// divergentFactor scales subsidence at divergent boundaries relative to uplift
+// at convergent ones, since rifts and trenches are narrower than ranges
+const divergentFactor = 0.5
+
+// ApplyPlateUplift raises hexes on convergent plate boundaries and lowers hexes
+// on divergent ones. Each plate drifts in its own fixed direction; a boundary
+// hex moves by upliftStrength times how fast its plate closes on (or pulls away
+// from) the neighboring plates. Interior hexes are unchanged. Land/water
+// classification is not updated, so callers should reclassify afterwards
+func ApplyPlateUplift(tiles []*HexTile, plates map[hex.AxialCoord]int, grid *hex.Grid, upliftStrength float64) {
+	for _, tile := range tiles {
+		id, ok := plates[tile.Coordinates]
+		if !ok {
+			continue
+		}
+		vx, vy := plateMotion(id)
+		cx, cy := tile.Coordinates.ToPixel(1)
+
+		closing, borders := 0.0, 0
+		for _, d := range hex.Directions {
+			neighbor, ok := grid.Neighbor(tile.Coordinates, d)
+			if !ok {
+				continue
+			}
+			other, ok := plates[neighbor]
+			if !ok || other == id {
+				continue
+			}
+
+			// Boundary normal from this hex toward the neighbor, before wrapping
+			nx, ny := tile.Coordinates.Neighbor(d).ToPixel(1)
+			nx, ny = nx-cx, ny-cy
+			length := math.Hypot(nx, ny)
+
+			ox, oy := plateMotion(other)
+			closing += ((vx-ox)*nx + (vy-oy)*ny) / length
+			borders++
+		}
+		if borders == 0 {
+			continue
+		}
+
+		closing /= float64(borders)
+		if closing < 0 {
+			closing *= divergentFactor
+		}
+		tile.Elevation += upliftStrength * closing
+	}
+}
+
+// plateMotion returns the unit drift vector of a plate. Successive plate IDs
+// are turned by the golden angle so directions stay well spread
+func plateMotion(id int) (dx, dy float64) {
+	const goldenAngle = 2.399963229728653
+	angle := float64(id) * goldenAngle
+	return math.Cos(angle), math.Sin(angle)
+}
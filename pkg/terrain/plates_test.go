@@ -0,0 +1,133 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestGeneratePlates(t *testing.T) {
+	configs := []hex.GridConfig{
+		{Width: 40, Height: 30, Topology: hex.TopologyRegion},
+		{Width: 40, Height: 30, Topology: hex.TopologyWorld},
+	}
+
+	for _, config := range configs {
+		grid := hex.NewGrid(config)
+		numPlates := 8
+		plates := GeneratePlates(grid, numPlates, 42)
+
+		sizes := make([]int, numPlates)
+		for _, coord := range grid.AllCoords() {
+			id, ok := plates[coord]
+			if !ok {
+				t.Fatalf("Hex %v not assigned to a plate", coord)
+			}
+			if id < 0 || id >= numPlates {
+				t.Fatalf("Hex %v has plate ID %d out of range", coord, id)
+			}
+			sizes[id]++
+		}
+		if len(plates) != len(grid.AllCoords()) {
+			t.Errorf("Expected %d assignments, got %d", len(grid.AllCoords()), len(plates))
+		}
+
+		mean := len(plates) / numPlates
+		for id, size := range sizes {
+			if size < mean/3 || size > mean*3 {
+				t.Errorf("Topology %d: plate %d has %d hexes, mean is %d", config.Topology, id, size, mean)
+			}
+		}
+
+		// Plates are contiguous
+		for id := 0; id < numPlates; id++ {
+			var start hex.AxialCoord
+			for coord, plate := range plates {
+				if plate == id {
+					start = coord
+					break
+				}
+			}
+			region := grid.FloodFill(start, func(c hex.AxialCoord) bool { return plates[c] == id })
+			if len(region) != sizes[id] {
+				t.Errorf("Topology %d: plate %d is split (%d of %d hexes connected)", config.Topology, id, len(region), sizes[id])
+			}
+		}
+	}
+}
+
+func TestGeneratePlatesDeterministic(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 20, Height: 15})
+
+	a := GeneratePlates(grid, 5, 7)
+	b := GeneratePlates(grid, 5, 7)
+	for coord, id := range a {
+		if b[coord] != id {
+			t.Fatalf("Plate assignment differs at %v for the same seed", coord)
+		}
+	}
+
+	if plates := GeneratePlates(grid, 0, 7); plates != nil {
+		t.Error("Expected no plates for numPlates < 1")
+	}
+
+	// More plates than hexes gives every hex its own plate
+	tiny := hex.NewGrid(hex.GridConfig{Width: 2, Height: 2})
+	plates := GeneratePlates(tiny, 10, 1)
+	seen := make(map[int]bool)
+	for _, id := range plates {
+		seen[id] = true
+	}
+	if len(plates) != 4 || len(seen) != 4 {
+		t.Errorf("Expected 4 single-hex plates, got %v", plates)
+	}
+}
+
+func TestApplyPlateUplift(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 40, Height: 30, Topology: hex.TopologyWorld})
+	plates := GeneratePlates(grid, 8, 42)
+
+	tiles := make([]*HexTile, 0, len(plates))
+	grid.ForEachCoord(func(coord hex.AxialCoord) {
+		tiles = append(tiles, &HexTile{Coordinates: coord, Elevation: 100})
+	})
+
+	ApplyPlateUplift(tiles, plates, grid, 1000)
+
+	var boundarySum, interiorSum float64
+	var boundaryCount, interiorCount int
+	raised, lowered := false, false
+	for _, tile := range tiles {
+		boundary := false
+		for _, neighbor := range tile.Coordinates.Neighbors(grid) {
+			if plates[neighbor] != plates[tile.Coordinates] {
+				boundary = true
+			}
+		}
+
+		if boundary {
+			boundarySum += tile.Elevation
+			boundaryCount++
+			raised = raised || tile.Elevation > 100
+			lowered = lowered || tile.Elevation < 100
+		} else {
+			interiorSum += tile.Elevation
+			interiorCount++
+			if tile.Elevation != 100 {
+				t.Errorf("Interior hex %v changed to %f", tile.Coordinates, tile.Elevation)
+			}
+		}
+	}
+
+	if boundaryCount == 0 || interiorCount == 0 {
+		t.Fatalf("Expected both boundary and interior hexes, got %d and %d", boundaryCount, interiorCount)
+	}
+	boundaryMean := boundarySum / float64(boundaryCount)
+	interiorMean := interiorSum / float64(interiorCount)
+	if boundaryMean <= interiorMean {
+		t.Errorf("Boundary mean %f should exceed interior mean %f", boundaryMean, interiorMean)
+	}
+	if !raised || !lowered {
+		t.Errorf("Expected both convergent uplift and divergent subsidence (raised %v, lowered %v)", raised, lowered)
+	}
+}
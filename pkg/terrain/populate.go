@@ -0,0 +1,25 @@
+package terrain
+
+import (
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// PopulateGrid stores each tile in the grid at its coordinates. Tiles outside a
+// region grid are skipped; on world grids coordinates wrap as in Grid.Set
+func PopulateGrid(grid *hex.Grid, tiles []*HexTile) {
+	for _, tile := range tiles {
+		grid.Set(tile.Coordinates, tile)
+	}
+}
+
+// TilesFromGrid collects the tiles stored in a grid in AllCoords order.
+// Coordinates holding no tile, or a value of another type, are skipped
+func TilesFromGrid(grid *hex.Grid) []*HexTile {
+	var tiles []*HexTile
+	grid.ForEachCoord(func(coord hex.AxialCoord) {
+		if tile, ok := grid.Get(coord).(*HexTile); ok && tile != nil {
+			tiles = append(tiles, tile)
+		}
+	})
+	return tiles
+}
@@ -0,0 +1,52 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestPopulateGridRoundTrip(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 9, Topology: hex.TopologyWorld})
+	tiles, err := TerrainFromGridWithSeed(grid, 5)
+	if err != nil {
+		t.Fatalf("Failed to generate terrain: %v", err)
+	}
+
+	stored := hex.NewGrid(grid.Config())
+	PopulateGrid(stored, tiles)
+
+	for _, tile := range tiles {
+		if got := stored.Get(tile.Coordinates); got != tile {
+			t.Errorf("Grid holds %v at %v, expected the original tile", got, tile.Coordinates)
+		}
+	}
+
+	roundTrip := TilesFromGrid(stored)
+	if len(roundTrip) != len(tiles) {
+		t.Fatalf("Expected %d tiles back, got %d", len(tiles), len(roundTrip))
+	}
+	byCoord := indexTiles(tiles)
+	for _, tile := range roundTrip {
+		if byCoord[tile.Coordinates] != tile {
+			t.Errorf("Tile at %v does not match the original", tile.Coordinates)
+		}
+	}
+}
+
+func TestTilesFromGridSkipsOtherValues(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 3, Height: 2, Topology: hex.TopologyRegion})
+	coords := grid.AllCoords()
+
+	grid.Set(coords[0], &HexTile{Coordinates: coords[0]})
+	grid.Set(coords[1], "not a tile")
+	grid.Set(coords[2], (*HexTile)(nil))
+
+	// Tiles outside a region grid are dropped
+	PopulateGrid(grid, []*HexTile{{Coordinates: hex.NewAxialCoord(50, 50)}})
+
+	tiles := TilesFromGrid(grid)
+	if len(tiles) != 1 || tiles[0].Coordinates != coords[0] {
+		t.Errorf("Expected only the tile at %v, got %v", coords[0], tiles)
+	}
+}
@@ -0,0 +1,92 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// ResampleTerrain bilinearly interpolates elevation from tiles on srcGrid onto
+// every hex of dstGrid, which may be finer or coarser, then reclassifies land
+// and water at sea level 0. Hexes are sampled by offset position with the
+// corner hexes aligned, so both grids cover the same extent and the source
+// edges are reproduced exactly
+func ResampleTerrain(tiles []*HexTile, srcGrid, dstGrid *hex.Grid) []*HexTile {
+	// Size both rasters to the grids' offset bounding boxes, so shaped grids
+	// are covered as well as rectangles
+	srcMinCol, srcMinRow, srcMaxCol, srcMaxRow := srcGrid.Bounds()
+	dstMinCol, dstMinRow, dstMaxCol, dstMaxRow := dstGrid.Bounds()
+	if srcMaxCol < srcMinCol || srcMaxRow < srcMinRow || dstMaxCol < dstMinCol || dstMaxRow < dstMinRow {
+		return nil
+	}
+	srcWidth, srcHeight := srcMaxCol-srcMinCol+1, srcMaxRow-srcMinRow+1
+
+	// Rasterize the source tiles by offset coordinates
+	source := make([][]float64, srcHeight)
+	for row := range source {
+		source[row] = make([]float64, srcWidth)
+	}
+	for _, tile := range tiles {
+		col, row := srcGrid.WrapCoord(tile.Coordinates).ToOffset()
+		col, row = col-srcMinCol, row-srcMinRow
+		if col >= 0 && col < srcWidth && row >= 0 && row < srcHeight {
+			source[row][col] = tile.Elevation
+		}
+	}
+
+	heightmap := resampleHeightmap(source, dstMaxCol-dstMinCol+1, dstMaxRow-dstMinRow+1)
+
+	coords := dstGrid.AllCoords()
+	resampled := make([]*HexTile, len(coords))
+	for i, coord := range coords {
+		col, row := coord.ToOffset()
+		resampled[i] = &HexTile{Coordinates: coord, Elevation: heightmap[row-dstMinRow][col-dstMinCol]}
+		resampled[i].ClassifyLandWater(0)
+	}
+	return resampled
+}
+
+// resampleHeightmap bilinearly resizes a heightmap to width x height with the
+// corner cells aligned
+func resampleHeightmap(source [][]float64, width, height int) [][]float64 {
+	scaleX, scaleY := 0.0, 0.0
+	if width > 1 {
+		scaleX = float64(len(source[0])-1) / float64(width-1)
+	}
+	if height > 1 {
+		scaleY = float64(len(source)-1) / float64(height-1)
+	}
+
+	heightmap := make([][]float64, height)
+	for row := range heightmap {
+		heightmap[row] = make([]float64, width)
+		for col := range heightmap[row] {
+			heightmap[row][col] = bilinearSample(source, float64(col)*scaleX, float64(row)*scaleY)
+		}
+	}
+
+	return heightmap
+}
+
+// bilinearSample interpolates a 2D grid at a fractional position, clamping to
+// the edges
+func bilinearSample(data [][]float64, x, y float64) float64 {
+	height := len(data)
+	width := len(data[0])
+
+	x = math.Max(0, math.Min(float64(width-1), x))
+	y = math.Max(0, math.Min(float64(height-1), y))
+
+	x0 := int(x)
+	y0 := int(y)
+	x1 := min(x0+1, width-1)
+	y1 := min(y0+1, height-1)
+
+	tx := x - float64(x0)
+	ty := y - float64(y0)
+
+	top := data[y0][x0]*(1-tx) + data[y0][x1]*tx
+	bottom := data[y1][x0]*(1-tx) + data[y1][x1]*tx
+
+	return top*(1-ty) + bottom*ty
+}
@@ -0,0 +1,110 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestResampleTerrainUpsample(t *testing.T) {
+	srcGrid := hex.NewGrid(hex.GridConfig{Width: 30, Height: 20, Topology: hex.TopologyRegion})
+	dstGrid := hex.NewGrid(hex.GridConfig{Width: 60, Height: 40, Topology: hex.TopologyRegion})
+
+	tiles, err := GenerateTerrain(srcGrid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	resampled := ResampleTerrain(tiles, srcGrid, dstGrid)
+	if len(resampled) != 60*40 {
+		t.Fatalf("Expected %d tiles, got %d", 60*40, len(resampled))
+	}
+
+	src := ValidateTerrain(tiles)
+	dst := ValidateTerrain(resampled)
+
+	// Interpolation can't leave the source range, and smoothing only trims
+	// isolated extremes, so the bulk of the distribution is preserved
+	span := src.ElevationRange[1] - src.ElevationRange[0]
+	if dst.ElevationRange[0] < src.ElevationRange[0] || dst.ElevationRange[1] > src.ElevationRange[1] {
+		t.Errorf("Resampled range %v exceeds source range %v", dst.ElevationRange, src.ElevationRange)
+	}
+	percentiles := []float64{0.05, 0.5, 0.95}
+	srcPercentiles := GetElevationPercentiles(tiles, percentiles)
+	dstPercentiles := GetElevationPercentiles(resampled, percentiles)
+	for i, p := range percentiles {
+		if math.Abs(srcPercentiles[i]-dstPercentiles[i]) > span*0.1 {
+			t.Errorf("%.0fth percentile %.1f differs from source %.1f",
+				p*100, dstPercentiles[i], srcPercentiles[i])
+		}
+	}
+
+	if math.Abs(dst.ElevationMean-src.ElevationMean) > span*0.05 {
+		t.Errorf("Resampled mean %.1f differs from source mean %.1f", dst.ElevationMean, src.ElevationMean)
+	}
+
+	for _, tile := range resampled {
+		if tile.IsLand != (tile.Elevation > 0) {
+			t.Errorf("Tile %v misclassified at elevation %.1f", tile.Coordinates, tile.Elevation)
+		}
+	}
+}
+
+func TestResampleTerrainIdentity(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 10, Topology: hex.TopologyRegion})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	resampled := ResampleTerrain(tiles, grid, grid)
+	for i, tile := range resampled {
+		if math.Abs(tile.Elevation-tiles[i].Elevation) > 1e-9 {
+			t.Errorf("Tile %v: elevation %f, expected %f", tile.Coordinates, tile.Elevation, tiles[i].Elevation)
+		}
+	}
+}
+
+func TestResampleTerrainShapedGrid(t *testing.T) {
+	srcGrid := hex.NewGrid(hex.GridConfig{Width: 21, Shape: hex.ShapeHexagon, Topology: hex.TopologyRegion})
+	dstGrid := hex.NewGrid(hex.GridConfig{Width: 41, Shape: hex.ShapeHexagon, Topology: hex.TopologyRegion})
+
+	// Elevation rises steadily from west to east, crossing sea level at the center column
+	srcCoords := srcGrid.AllCoords()
+	tiles := make([]*HexTile, len(srcCoords))
+	for i, coord := range srcCoords {
+		col, _ := coord.ToOffset()
+		tiles[i] = &HexTile{Coordinates: coord, Elevation: float64(col-10) * 100}
+		tiles[i].ClassifyLandWater(0)
+	}
+
+	// Resampling onto the same hexagon reproduces it exactly
+	identity := ResampleTerrain(tiles, srcGrid, srcGrid)
+	if len(identity) != len(tiles) {
+		t.Fatalf("Expected %d tiles, got %d", len(tiles), len(identity))
+	}
+	for i, tile := range identity {
+		if math.Abs(tile.Elevation-tiles[i].Elevation) > 1e-9 {
+			t.Errorf("Tile %v: elevation %f, expected %f", tile.Coordinates, tile.Elevation, tiles[i].Elevation)
+		}
+	}
+
+	resampled := ResampleTerrain(tiles, srcGrid, dstGrid)
+	if len(resampled) != len(dstGrid.AllCoords()) {
+		t.Fatalf("Expected %d tiles, got %d", len(dstGrid.AllCoords()), len(resampled))
+	}
+
+	// The center hex keeps the source center's elevation and the slope keeps its direction
+	byCoord := indexTiles(resampled)
+	centerCoord := hex.OffsetToAxial(20, 20)
+	center := byCoord[centerCoord]
+	if center == nil || math.Abs(center.Elevation) > 1e-9 {
+		t.Errorf("Expected center elevation 0, got %+v", center)
+	}
+	west := byCoord[hex.NewAxialCoord(centerCoord.Q-10, centerCoord.R+5)]
+	east := byCoord[hex.NewAxialCoord(centerCoord.Q+10, centerCoord.R-5)]
+	if west == nil || east == nil || west.Elevation >= 0 || east.Elevation <= 0 {
+		t.Errorf("Expected west below and east above sea level, got %+v and %+v", west, east)
+	}
+}
@@ -0,0 +1,206 @@
+package terrain
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// River is an ordered path of hexes from source to mouth
+type River []hex.AxialCoord
+
+// RiverConfig controls river generation
+type RiverConfig struct {
+	FlowThreshold float64 `json:"flow_threshold"` // Upstream tiles draining through a hex to make it a river
+	MinLength     int     `json:"min_length"`     // Shortest river (in hexes) to keep
+}
+
+// DefaultRiverConfig returns reasonable river generation parameters
+func DefaultRiverConfig() RiverConfig {
+	return RiverConfig{
+		FlowThreshold: 20,
+		MinLength:     3,
+	}
+}
+
+// GenerateRivers routes water downhill from every land tile to the sea,
+// accumulating flow, and returns rivers wherever flow exceeds the threshold.
+// Local minima are handled by filling basins up to their spill point, so water
+// crossing a basin flows through it as a lake instead of getting stuck.
+// Each river runs from its source to its mouth, which is either a water tile
+// or the point where it joins a larger river
+func GenerateRivers(tiles []*HexTile, grid *hex.Grid, config RiverConfig) []River {
+	tileMap := indexTiles(tiles)
+	downstream, order := drainageTree(tiles, tileMap, grid)
+
+	// Accumulate flow from the highest (last drained) tiles downward
+	flow := make(map[hex.AxialCoord]float64, len(tiles))
+	for i := len(order) - 1; i >= 0; i-- {
+		coord := order[i]
+		if tileMap[coord].IsLand {
+			flow[coord]++
+		}
+		if next, ok := downstream[coord]; ok {
+			flow[next] += flow[coord]
+		}
+	}
+
+	isRiver := func(c hex.AxialCoord) bool {
+		return tileMap[c].IsLand && flow[c] >= config.FlowThreshold
+	}
+
+	// Sources are river hexes with no river flowing into them
+	hasUpstream := make(map[hex.AxialCoord]bool)
+	for _, tile := range tiles {
+		if !isRiver(tile.Coordinates) {
+			continue
+		}
+		if next, ok := downstream[tile.Coordinates]; ok && isRiver(next) {
+			hasUpstream[next] = true
+		}
+	}
+
+	var sources []*HexTile
+	for _, tile := range tiles {
+		if isRiver(tile.Coordinates) && !hasUpstream[tile.Coordinates] {
+			sources = append(sources, tile)
+		}
+	}
+
+	// Trace the highest sources first so they claim the main stem
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].Elevation > sources[j].Elevation
+	})
+
+	claimed := make(map[hex.AxialCoord]bool)
+	var rivers []River
+
+	for _, source := range sources {
+		river := River{source.Coordinates}
+		claimed[source.Coordinates] = true
+
+		for current := source.Coordinates; ; {
+			next, ok := downstream[current]
+			if !ok {
+				break
+			}
+
+			river = append(river, next)
+			if !tileMap[next].IsLand || claimed[next] {
+				break // reached the sea or joined another river
+			}
+
+			claimed[next] = true
+			current = next
+		}
+
+		if len(river) >= config.MinLength {
+			rivers = append(rivers, river)
+		}
+	}
+
+	return rivers
+}
+
+// drainageTree computes the downstream neighbor of every tile using a
+// priority flood from the sea. Tiles are drained in order of their filled
+// (spill) level, so following downstream links always reaches an outlet.
+// Returns the downstream links and the order tiles were drained in
+func drainageTree(tiles []*HexTile, tileMap map[hex.AxialCoord]*HexTile, grid *hex.Grid) (map[hex.AxialCoord]hex.AxialCoord, []hex.AxialCoord) {
+	downstream := make(map[hex.AxialCoord]hex.AxialCoord, len(tiles))
+	order := make([]hex.AxialCoord, 0, len(tiles))
+	queued := make(map[hex.AxialCoord]bool, len(tiles))
+	open := &floodQueue{}
+
+	push := func(coord hex.AxialCoord, level float64) {
+		queued[coord] = true
+		heap.Push(open, &floodNode{coord: coord, level: level})
+	}
+
+	// Water tiles are outlets
+	for _, tile := range tiles {
+		if !tile.IsLand {
+			push(tile.Coordinates, tile.Elevation)
+		}
+	}
+
+	// Without any water, drain off the map edge (or the lowest tile on world maps)
+	if open.Len() == 0 {
+		for _, tile := range tiles {
+			if tile.Coordinates.IsEdgeHex(grid) {
+				push(tile.Coordinates, tile.Elevation)
+			}
+		}
+	}
+	if open.Len() == 0 && len(tiles) > 0 {
+		lowest := tiles[0]
+		for _, tile := range tiles {
+			if tile.Elevation < lowest.Elevation {
+				lowest = tile
+			}
+		}
+		push(lowest.Coordinates, lowest.Elevation)
+	}
+
+	for open.Len() > 0 {
+		node := heap.Pop(open).(*floodNode)
+		order = append(order, node.coord)
+
+		for _, neighbor := range node.coord.Neighbors(grid) {
+			tile, ok := tileMap[neighbor]
+			if !ok || queued[neighbor] {
+				continue
+			}
+
+			// Filling basins: a tile never drains below the level it spills at
+			level := tile.Elevation
+			if level < node.level {
+				level = node.level
+			}
+
+			downstream[neighbor] = node.coord
+			push(neighbor, level)
+		}
+	}
+
+	return downstream, order
+}
+
+// floodNode is an entry in the priority-flood queue
+type floodNode struct {
+	coord hex.AxialCoord
+	level float64
+	order int // insertion order, used to break ties deterministically
+}
+
+// floodQueue is a min-heap of flood nodes ordered by level
+type floodQueue struct {
+	nodes   []*floodNode
+	counter int
+}
+
+func (q *floodQueue) Len() int { return len(q.nodes) }
+
+func (q *floodQueue) Less(i, j int) bool {
+	if q.nodes[i].level != q.nodes[j].level {
+		return q.nodes[i].level < q.nodes[j].level
+	}
+	return q.nodes[i].order < q.nodes[j].order
+}
+
+func (q *floodQueue) Swap(i, j int) { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+
+func (q *floodQueue) Push(x interface{}) {
+	node := x.(*floodNode)
+	node.order = q.counter
+	q.counter++
+	q.nodes = append(q.nodes, node)
+}
+
+func (q *floodQueue) Pop() interface{} {
+	last := len(q.nodes) - 1
+	node := q.nodes[last]
+	q.nodes = q.nodes[:last]
+	return node
+}
@@ -0,0 +1,105 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// slopeTiles creates terrain that rises steadily away from a sea in column 0
+func slopeTiles(grid *hex.Grid) []*HexTile {
+	return elevationTiles(grid, func(col, row int) float64 {
+		if col == 0 {
+			return -500.0
+		}
+		return float64(col)*100 + float64(row%3)*10
+	})
+}
+
+func TestGenerateRivers(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 10, Topology: hex.TopologyRegion})
+	tiles := slopeTiles(grid)
+	tileMap := indexTiles(tiles)
+
+	config := RiverConfig{FlowThreshold: 5, MinLength: 3}
+	rivers := GenerateRivers(tiles, grid, config)
+
+	if len(rivers) == 0 {
+		t.Fatal("Expected rivers on a sloped coast")
+	}
+
+	reachesSea := false
+	for i, river := range rivers {
+		if len(river) < config.MinLength {
+			t.Errorf("River %d shorter than minimum length: %d", i, len(river))
+		}
+
+		if !tileMap[river[0]].IsLand {
+			t.Errorf("River %d starts in water at %v", i, river[0])
+		}
+
+		for j := 1; j < len(river); j++ {
+			if river[j-1].DistanceTo(river[j], grid) != 1 {
+				t.Errorf("River %d not connected at step %d: %v to %v", i, j, river[j-1], river[j])
+			}
+		}
+
+		if !tileMap[river[len(river)-1]].IsLand {
+			reachesSea = true
+		}
+	}
+
+	if !reachesSea {
+		t.Error("Expected at least one river to reach the sea")
+	}
+
+	// Generation is deterministic
+	again := GenerateRivers(tiles, grid, config)
+	if len(again) != len(rivers) {
+		t.Fatalf("Non-deterministic river count: %d vs %d", len(rivers), len(again))
+	}
+	for i := range rivers {
+		for j := range rivers[i] {
+			if rivers[i][j] != again[i][j] {
+				t.Fatalf("Non-deterministic river %d at step %d", i, j)
+			}
+		}
+	}
+}
+
+func TestGenerateRiversFillsBasins(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 10, Topology: hex.TopologyRegion})
+	tiles := slopeTiles(grid)
+	tileMap := indexTiles(tiles)
+
+	// Dig a land-locked pit across the middle of the slope
+	for row := 0; row < 10; row++ {
+		tileMap[hex.OffsetToAxial(6, row)].Elevation = 50
+	}
+
+	rivers := GenerateRivers(tiles, grid, RiverConfig{FlowThreshold: 5, MinLength: 3})
+
+	// Water from above the basin must still make it to the sea
+	for _, river := range rivers {
+		col, _ := river[0].ToOffset()
+		if col <= 6 {
+			continue
+		}
+
+		mouth := river[len(river)-1]
+		if tileMap[mouth].IsLand {
+			// Tributaries end where they join another river, which must itself drain
+			joined := false
+			for _, other := range rivers {
+				for _, coord := range other[:len(other)-1] {
+					if coord == mouth {
+						joined = true
+					}
+				}
+			}
+			if !joined {
+				t.Errorf("River from %v is stuck at %v", river[0], mouth)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package terrain
+
+import (
+	"math"
+)
+
+// calibrationIterations bounds the sea level bisection; 64 halvings exhaust
+// float64 precision for any realistic elevation span
+const calibrationIterations = 64
+
+// CalibrateSeaLevel binary-searches the sea level at which the fraction of
+// land tiles is closest to targetLandRatio, reclassifies every tile against
+// it and returns it. Ties in elevation can make the exact ratio unreachable,
+// in which case the nearest achievable ratio is used
+func CalibrateSeaLevel(tiles []*HexTile, targetLandRatio float64) float64 {
+	if len(tiles) == 0 {
+		return SeaLevelDefault
+	}
+
+	targetRatio := math.Max(0, math.Min(1, targetLandRatio))
+	target := int(math.Round(targetRatio * float64(len(tiles))))
+
+	minElev, maxElev := math.Inf(1), math.Inf(-1)
+	for _, tile := range tiles {
+		minElev = math.Min(minElev, tile.Elevation)
+		maxElev = math.Max(maxElev, tile.Elevation)
+	}
+
+	landAbove := func(level float64) int {
+		count := 0
+		for _, tile := range tiles {
+			if tile.Elevation > level {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Every tile is land below the lowest elevation and none at the highest
+	low, high := minElev-1, maxElev
+	lowCount, highCount := len(tiles), 0
+	for i := 0; i < calibrationIterations && lowCount != target && highCount != target; i++ {
+		mid := low + (high-low)/2
+		if mid <= low || mid >= high {
+			break
+		}
+
+		count := landAbove(mid)
+		if count > target {
+			low, lowCount = mid, count
+		} else {
+			high, highCount = mid, count
+		}
+	}
+
+	seaLevel := high
+	if lowCount-target < target-highCount {
+		seaLevel = low
+	}
+
+	for _, tile := range tiles {
+		tile.ClassifyLandWater(seaLevel)
+	}
+
+	return seaLevel
+}
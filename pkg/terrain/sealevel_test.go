@@ -0,0 +1,78 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestCalibrateSeaLevelKnownHeightmap(t *testing.T) {
+	// Elevations -50..49 in a shuffled order
+	var tiles []*HexTile
+	for i := 0; i < 100; i++ {
+		tiles = append(tiles, &HexTile{
+			Coordinates: hex.NewAxialCoord(i, 0),
+			Elevation:   float64((i*37)%100 - 50),
+		})
+	}
+
+	for _, ratio := range []float64{0, 0.29, 0.5, 0.73, 1} {
+		seaLevel := CalibrateSeaLevel(tiles, ratio)
+
+		land := 0
+		for _, tile := range tiles {
+			if tile.IsLand != (tile.Elevation > seaLevel) {
+				t.Fatalf("Tile %v not reclassified against sea level %f", tile.Coordinates, seaLevel)
+			}
+			if tile.IsLand {
+				land++
+			}
+		}
+
+		if expected := int(math.Round(ratio * 100)); land != expected {
+			t.Errorf("Ratio %.2f: expected %d land tiles, got %d (sea level %f)", ratio, expected, land, seaLevel)
+		}
+	}
+}
+
+func TestCalibrateSeaLevelGeneratedTerrain(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 60, Height: 40})
+	config := DefaultTerrainConfig()
+
+	tiles, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+
+	CalibrateSeaLevel(tiles, config.LandRatio)
+
+	stats := ValidateTerrain(tiles)
+	if math.Abs(stats.LandPercentage-config.LandRatio*100) > 0.5 {
+		t.Errorf("Land percentage %.2f%% not within 0.5%% of target %.2f%%",
+			stats.LandPercentage, config.LandRatio*100)
+	}
+}
+
+func TestCalibrateSeaLevelTies(t *testing.T) {
+	tiles := []*HexTile{
+		{Elevation: 10}, {Elevation: 10}, {Elevation: 10}, {Elevation: 20},
+	}
+
+	// Two land tiles are unreachable; the nearest split keeps the single high tile
+	CalibrateSeaLevel(tiles, 0.4)
+
+	land := 0
+	for _, tile := range tiles {
+		if tile.IsLand {
+			land++
+		}
+	}
+	if land != 1 {
+		t.Errorf("Expected 1 land tile, got %d", land)
+	}
+
+	if got := CalibrateSeaLevel(nil, 0.5); got != SeaLevelDefault {
+		t.Errorf("Empty tiles should return default sea level, got %f", got)
+	}
+}
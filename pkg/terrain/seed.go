@@ -0,0 +1,53 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// targetLandmasses is the landmass count above which terrain is considered
+// fragmented, roughly Earth's number of continents
+const targetLandmasses = 7
+
+// FindRealisticSeed generates terrain for every seed, calibrates its sea level
+// to config.LandRatio and returns the seed whose terrain scores best on
+// realism, together with its statistics. Realism combines ComputeQualityScore
+// with how few landmasses the land is split into. Ties go to the earlier
+// seed; if no seed generates, the zero seed and stats are returned
+func FindRealisticSeed(grid *hex.Grid, config TerrainConfig, seeds []int64) (int64, TerrainStats) {
+	results := GenerateBatch(grid, config, seeds)
+
+	var bestSeed int64
+	var bestStats TerrainStats
+	bestScore := math.Inf(-1)
+	for _, seed := range seeds {
+		tiles, ok := results[seed]
+		if !ok {
+			continue
+		}
+
+		CalibrateSeaLevel(tiles, config.LandRatio)
+		stats := ValidateTerrain(tiles)
+		if score := seedRealismScore(stats); score > bestScore {
+			bestSeed, bestStats, bestScore = seed, stats, score
+		}
+	}
+
+	return bestSeed, bestStats
+}
+
+// seedRealismScore rates terrain statistics from 0 to 1 for seed selection
+func seedRealismScore(stats TerrainStats) float64 {
+	if stats.TotalTiles == 0 {
+		return 0
+	}
+
+	landmassScore := 0.0
+	if stats.LandmassCount > 0 {
+		excess := float64(max(0, stats.LandmassCount-targetLandmasses))
+		landmassScore = 1 / (1 + excess/targetLandmasses)
+	}
+
+	return 0.8*ComputeQualityScore(stats) + 0.2*landmassScore
+}
@@ -0,0 +1,71 @@
+package terrain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestFindRealisticSeed(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 30, Height: 20})
+	config := DefaultTerrainConfig()
+	seeds := []int64{3, 17, 42, 99, 512}
+
+	bestSeed, bestStats := FindRealisticSeed(grid, config, seeds)
+
+	bestScore := -1.0
+	var expected int64
+	for _, seed := range seeds {
+		seedConfig := config
+		seedConfig.Seed = seed
+		tiles, err := GenerateTerrain(grid, seedConfig)
+		if err != nil {
+			t.Fatalf("GenerateTerrain failed for seed %d: %v", seed, err)
+		}
+		CalibrateSeaLevel(tiles, config.LandRatio)
+		if score := seedRealismScore(ValidateTerrain(tiles)); score > bestScore {
+			bestScore, expected = score, seed
+		}
+	}
+
+	if bestSeed != expected {
+		t.Errorf("Expected best seed %d, got %d", expected, bestSeed)
+	}
+	if score := seedRealismScore(bestStats); score != bestScore {
+		t.Errorf("Returned stats score %f, expected %f", score, bestScore)
+	}
+	if landRatio := bestStats.LandPercentage / 100; math.Abs(landRatio-config.LandRatio) > 0.01 {
+		t.Errorf("Expected calibrated land ratio %.2f, got %.2f", config.LandRatio, landRatio)
+	}
+}
+
+func TestSeedRealismScore(t *testing.T) {
+	good := TerrainStats{
+		TotalTiles:       1000,
+		LandTiles:        290,
+		LandPercentage:   29,
+		HypsometricMatch: 0.95,
+		LandmassCount:    4,
+		LargestLandmass:  200,
+		ElevationStdDev:  1500,
+	}
+	fragmented := good
+	fragmented.LandmassCount = 70
+	allWater := good
+	allWater.LandPercentage, allWater.LandTiles, allWater.LandmassCount = 0, 0, 0
+
+	goodScore := seedRealismScore(good)
+	if goodScore < 0.9 || goodScore > 1 {
+		t.Errorf("Realistic terrain should score near 1, got %f", goodScore)
+	}
+	if score := seedRealismScore(fragmented); score >= goodScore {
+		t.Errorf("Fragmented terrain should score below %f, got %f", goodScore, score)
+	}
+	if score := seedRealismScore(allWater); score >= goodScore {
+		t.Errorf("All-water terrain should score below %f, got %f", goodScore, score)
+	}
+	if score := seedRealismScore(TerrainStats{}); score != 0 {
+		t.Errorf("Empty stats should score 0, got %f", score)
+	}
+}
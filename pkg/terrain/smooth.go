@@ -0,0 +1,73 @@
+package terrain
+
+import (
+	"sort"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// SmoothElevation runs iterations of neighbor-average smoothing, moving each
+// tile's elevation by strength (0 to 1) of the way toward the mean of its
+// neighbors. All tiles update together each pass, so results do not depend
+// on tile order. Strength 0 leaves elevations unchanged. Land/water
+// classification is not updated
+func SmoothElevation(tiles []*HexTile, grid *hex.Grid, iterations int, strength float64) {
+	strength = max(0, min(1, strength))
+	if strength == 0 {
+		return
+	}
+
+	tileMap := indexTiles(tiles)
+	smoothed := make([]float64, len(tiles))
+	for pass := 0; pass < iterations; pass++ {
+		for i, tile := range tiles {
+			sum, count := 0.0, 0
+			for _, neighbor := range tile.Coordinates.Neighbors(grid) {
+				if other, ok := tileMap[neighbor]; ok {
+					sum += other.Elevation
+					count++
+				}
+			}
+
+			smoothed[i] = tile.Elevation
+			if count > 0 {
+				smoothed[i] += strength * (sum/float64(count) - tile.Elevation)
+			}
+		}
+
+		for i, tile := range tiles {
+			tile.Elevation = smoothed[i]
+		}
+	}
+}
+
+// MedianFilterElevation replaces each tile's elevation with the median of
+// itself and its neighbors. Unlike SmoothElevation this removes single-tile
+// spikes and pits while keeping the edges of broad features sharp. With an
+// even number of values the two middle values are averaged. Land/water
+// classification is not updated
+func MedianFilterElevation(tiles []*HexTile, grid *hex.Grid) {
+	tileMap := indexTiles(tiles)
+	filtered := make([]float64, len(tiles))
+	window := make([]float64, 0, 7)
+
+	for i, tile := range tiles {
+		window = append(window[:0], tile.Elevation)
+		for _, neighbor := range tile.Coordinates.Neighbors(grid) {
+			if other, ok := tileMap[neighbor]; ok {
+				window = append(window, other.Elevation)
+			}
+		}
+
+		sort.Float64s(window)
+		mid := len(window) / 2
+		filtered[i] = window[mid]
+		if len(window)%2 == 0 {
+			filtered[i] = (window[mid-1] + window[mid]) / 2
+		}
+	}
+
+	for i, tile := range tiles {
+		tile.Elevation = filtered[i]
+	}
+}
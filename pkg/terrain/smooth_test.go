@@ -0,0 +1,122 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// flatTiles builds a tile at every grid coordinate with the same elevation
+func flatTiles(grid *hex.Grid, elevation float64) []*HexTile {
+	var tiles []*HexTile
+	grid.ForEachCoord(func(coord hex.AxialCoord) {
+		tiles = append(tiles, &HexTile{Coordinates: coord, Elevation: elevation})
+	})
+	return tiles
+}
+
+func TestSmoothElevation(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 20, Height: 16, Topology: hex.TopologyWorld})
+	tiles, err := TerrainFromGridWithSeed(grid, 11)
+	if err != nil {
+		t.Fatalf("Failed to generate terrain: %v", err)
+	}
+
+	elevations := func() []float64 {
+		values := make([]float64, len(tiles))
+		for i, tile := range tiles {
+			values[i] = tile.Elevation
+		}
+		return values
+	}
+
+	before := elevations()
+	beforeStdDev := calculateStdDev(before, calculateMean(before))
+
+	SmoothElevation(tiles, grid, 3, 0)
+	for i, tile := range tiles {
+		if tile.Elevation != before[i] {
+			t.Fatalf("Strength 0 changed tile %v from %f to %f", tile.Coordinates, before[i], tile.Elevation)
+		}
+	}
+
+	SmoothElevation(tiles, grid, 3, 0.5)
+	after := elevations()
+	if afterStdDev := calculateStdDev(after, calculateMean(after)); afterStdDev >= beforeStdDev {
+		t.Errorf("Expected smoothing to reduce standard deviation, got %f -> %f", beforeStdDev, afterStdDev)
+	}
+}
+
+func TestSmoothElevationRemovesSpike(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 9, Height: 9, Topology: hex.TopologyRegion})
+	tiles := flatTiles(grid, 100)
+
+	spike := indexTiles(tiles)[hex.OffsetToAxial(4, 4)]
+	spike.Elevation = 50000
+
+	SmoothElevation(tiles, grid, 10, 0.5)
+
+	if spike.Elevation > 5000 {
+		t.Errorf("Expected the spike to be flattened, still at %f", spike.Elevation)
+	}
+	// No tile stands out from its neighbors the way the spike did
+	tileMap := indexTiles(tiles)
+	for _, tile := range tiles {
+		sum, count := 0.0, 0
+		for _, neighbor := range tile.Coordinates.Neighbors(grid) {
+			sum += tileMap[neighbor].Elevation
+			count++
+		}
+		if excess := tile.Elevation - sum/float64(count); excess > 1000 {
+			t.Errorf("Tile %v is still %f m above its neighbors", tile.Coordinates, excess)
+		}
+	}
+}
+
+func TestMedianFilterElevation(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 15, Height: 15, Topology: hex.TopologyRegion})
+
+	// A lone spike collapses to its surroundings
+	tiles := flatTiles(grid, 100)
+	spike := indexTiles(tiles)[hex.OffsetToAxial(3, 3)]
+	spike.Elevation = 50000
+
+	MedianFilterElevation(tiles, grid)
+	for _, tile := range tiles {
+		if tile.Elevation != 100 {
+			t.Errorf("Tile %v is %f after filtering, expected 100", tile.Coordinates, tile.Elevation)
+		}
+	}
+
+	// A broad hexagonal plateau keeps its shape and height
+	tiles = flatTiles(grid, 100)
+	center := hex.OffsetToAxial(8, 8)
+	expected := make(map[hex.AxialCoord]float64)
+	for _, tile := range tiles {
+		if tile.Coordinates.DistanceTo(center, grid) <= 3 {
+			tile.Elevation = 2000
+		}
+		expected[tile.Coordinates] = tile.Elevation
+	}
+
+	MedianFilterElevation(tiles, grid)
+	for _, tile := range tiles {
+		if tile.Elevation != expected[tile.Coordinates] {
+			t.Errorf("Plateau tile %v changed from %f to %f", tile.Coordinates, expected[tile.Coordinates], tile.Elevation)
+		}
+	}
+}
+
+func TestMedianFilterElevationEdges(t *testing.T) {
+	// Each hex of a two-hex grid has one neighbor, so its window holds two values
+	grid := hex.NewGrid(hex.GridConfig{Width: 2, Height: 1, Topology: hex.TopologyRegion})
+	tiles := []*HexTile{
+		{Coordinates: hex.OffsetToAxial(0, 0), Elevation: 10},
+		{Coordinates: hex.OffsetToAxial(1, 0), Elevation: 30},
+	}
+
+	MedianFilterElevation(tiles, grid)
+	if tiles[0].Elevation != 20 || tiles[1].Elevation != 20 {
+		t.Errorf("Expected both tiles at 20, got %f and %f", tiles[0].Elevation, tiles[1].Elevation)
+	}
+}
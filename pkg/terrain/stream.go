@@ -0,0 +1,81 @@
+package terrain
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// TerrainStreamHeader is the first line of a JSON-lines terrain stream
+type TerrainStreamHeader struct {
+	Config TerrainConfig `json:"config"`
+	Grid   GridInfo      `json:"grid"`
+}
+
+// StreamTerrain generates terrain and writes it as JSON lines: a header line
+// followed by one tile per line, in grid order. Tiles are built and encoded
+// one at a time without listing the grid's coordinates, so only the heightmap
+// is held in memory. A seed drawn from config.Rand is recorded in the header
+func StreamTerrain(w io.Writer, grid *hex.Grid, config TerrainConfig) error {
+	config = config.resolveRand()
+	heightmap, err := terrainHeightmap(grid, config)
+	if err != nil {
+		return err
+	}
+
+	buffered := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffered)
+
+	header := TerrainStreamHeader{Config: config, Grid: NewGridInfo(grid)}
+	if err := encoder.Encode(header); err != nil {
+		return err
+	}
+
+	grid.ForEachCoord(func(coord hex.AxialCoord) {
+		if err == nil {
+			err = encoder.Encode(heightmapTile(heightmap, coord, config.SeaLevel))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+// TerrainStreamReader reads tiles written by StreamTerrain one at a time
+type TerrainStreamReader struct {
+	decoder *json.Decoder
+	header  TerrainStreamHeader
+}
+
+// NewTerrainStreamReader reads the stream header and prepares to read tiles
+func NewTerrainStreamReader(r io.Reader) (*TerrainStreamReader, error) {
+	decoder := json.NewDecoder(bufio.NewReader(r))
+
+	var header TerrainStreamHeader
+	if err := decoder.Decode(&header); err != nil {
+		if err == io.EOF {
+			return nil, &TerrainError{"terrain stream is missing its header"}
+		}
+		return nil, err
+	}
+
+	return &TerrainStreamReader{decoder: decoder, header: header}, nil
+}
+
+// Header returns the configuration and grid layout the stream was generated with
+func (sr *TerrainStreamReader) Header() TerrainStreamHeader {
+	return sr.header
+}
+
+// Next returns the next tile in the stream, or io.EOF once all tiles are read
+func (sr *TerrainStreamReader) Next() (*HexTile, error) {
+	var tile HexTile
+	if err := sr.decoder.Decode(&tile); err != nil {
+		return nil, err
+	}
+	return &tile, nil
+}
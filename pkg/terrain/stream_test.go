@@ -0,0 +1,105 @@
+package terrain
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestStreamTerrainRoundTrip(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 24, Height: 18, Topology: hex.TopologyWorld})
+	config := DefaultTerrainConfig()
+
+	expected, err := GenerateTerrain(grid, config)
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := StreamTerrain(&buf, grid, config); err != nil {
+		t.Fatalf("StreamTerrain failed: %v", err)
+	}
+
+	// One header line plus one line per tile
+	lines := strings.Count(buf.String(), "\n")
+	if lines != len(expected)+1 {
+		t.Errorf("Expected %d lines, got %d", len(expected)+1, lines)
+	}
+
+	reader, err := NewTerrainStreamReader(&buf)
+	if err != nil {
+		t.Fatalf("NewTerrainStreamReader failed: %v", err)
+	}
+
+	header := reader.Header()
+	if header.Config != config {
+		t.Errorf("Header config mismatch: %+v", header.Config)
+	}
+	if header.Grid != NewGridInfo(grid) {
+		t.Errorf("Header grid mismatch: %+v", header.Grid)
+	}
+
+	var tiles []*HexTile
+	for {
+		tile, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		tiles = append(tiles, tile)
+	}
+
+	if len(tiles) != len(expected) {
+		t.Fatalf("Expected %d tiles, got %d", len(expected), len(tiles))
+	}
+	for i := range tiles {
+		if *tiles[i] != *expected[i] {
+			t.Errorf("Tile %d: got %+v, want %+v", i, *tiles[i], *expected[i])
+		}
+	}
+}
+
+func TestStreamTerrainErrors(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 4, Height: 4})
+	config := DefaultTerrainConfig()
+	config.LandRatio = 2.0
+
+	var buf bytes.Buffer
+	if err := StreamTerrain(&buf, grid, config); err == nil {
+		t.Error("Expected error for invalid config")
+	}
+	if buf.Len() != 0 {
+		t.Error("Nothing should be written for an invalid config")
+	}
+
+	if _, err := NewTerrainStreamReader(strings.NewReader("")); err == nil {
+		t.Error("Expected error for empty stream")
+	}
+}
+
+func TestStreamTerrainRecordsDrawnSeed(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 8, Topology: hex.TopologyRegion})
+	config := DefaultTerrainConfig()
+	config.Rand = rand.New(rand.NewSource(7))
+
+	var buf bytes.Buffer
+	if err := StreamTerrain(&buf, grid, config); err != nil {
+		t.Fatalf("StreamTerrain failed: %v", err)
+	}
+
+	reader, err := NewTerrainStreamReader(&buf)
+	if err != nil {
+		t.Fatalf("NewTerrainStreamReader failed: %v", err)
+	}
+
+	// The header's seed alone reproduces the streamed terrain
+	if want := rand.New(rand.NewSource(7)).Int63(); reader.Header().Config.Seed != want {
+		t.Errorf("Expected header seed %d, got %d", want, reader.Header().Config.Seed)
+	}
+}
@@ -0,0 +1,25 @@
+package terrain
+
+import (
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// SubsetTiles returns copies of the tiles inside a width x height offset window
+// starting at (minCol, minRow), with coordinates re-based to the window origin
+// to match the grid returned by hex.Grid.Subgrid. Tiles keep their input order
+func SubsetTiles(tiles []*HexTile, minCol, minRow, width, height int) []*HexTile {
+	var subset []*HexTile
+
+	for _, tile := range tiles {
+		col, row := tile.Coordinates.ToOffset()
+		if col < minCol || col >= minCol+width || row < minRow || row >= minRow+height {
+			continue
+		}
+
+		copied := *tile
+		copied.Coordinates = hex.OffsetToAxial(col-minCol, row-minRow)
+		subset = append(subset, &copied)
+	}
+
+	return subset
+}
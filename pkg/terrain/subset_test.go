@@ -0,0 +1,43 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestSubsetTiles(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 24, Height: 16, Topology: hex.TopologyRegion})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain() failed: %v", err)
+	}
+
+	sub, err := grid.Subgrid(6, 4, 10, 8)
+	if err != nil {
+		t.Fatalf("Subgrid() failed: %v", err)
+	}
+	subset := SubsetTiles(tiles, 6, 4, 10, 8)
+
+	if len(subset) != len(sub.AllCoords()) {
+		t.Fatalf("Expected %d tiles, got %d", len(sub.AllCoords()), len(subset))
+	}
+
+	original := indexTiles(tiles)
+	for _, tile := range subset {
+		if !sub.IsValid(tile.Coordinates) {
+			t.Errorf("Tile %v is outside the subgrid", tile.Coordinates)
+		}
+
+		col, row := tile.Coordinates.ToOffset()
+		source := original[hex.OffsetToAxial(col+6, row+4)]
+		if source.Elevation != tile.Elevation || source.IsLand != tile.IsLand {
+			t.Errorf("Tile %v doesn't match its source %v", tile, source)
+		}
+	}
+
+	// The input tiles are left untouched
+	if tiles[0].Coordinates != hex.OffsetToAxial(0, 0) {
+		t.Errorf("SubsetTiles modified the input tiles")
+	}
+}
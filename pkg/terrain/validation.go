@@ -1,8 +1,11 @@
 package terrain
 
 import (
+	"fmt"
 	"math"
 	"sort"
+
+	"github.com/sean/hex-map/pkg/hex"
 )
 
 // ValidateTerrain performs comprehensive statistical analysis of generated terrain
@@ -38,6 +41,9 @@ func ValidateTerrain(tiles []*HexTile) TerrainStats {
 	// Calculate hypsometric curve match
 	hypsometricMatch := calculateHypsometricMatch(elevations)
 	
+	// Measure land fragmentation
+	landmassCount, largestLandmass := landmassStats(tiles)
+	
 	return TerrainStats{
 		ElevationRange:   [2]float64{minElev, maxElev},
 		ElevationMean:    meanElev,
@@ -48,40 +54,185 @@ func ValidateTerrain(tiles []*HexTile) TerrainStats {
 		TotalTiles:       totalTiles,
 		LandTiles:        landCount,
 		WaterTiles:       waterCount,
+		LandmassCount:    landmassCount,
+		LargestLandmass:  largestLandmass,
+	}
+}
+
+// RealismCriteria holds the thresholds terrain must meet to count as realistic
+type RealismCriteria struct {
+	MinElevation               float64 `json:"min_elevation"`                 // Deepest tolerated elevation (m)
+	MaxElevation               float64 `json:"max_elevation"`                 // Highest tolerated elevation (m)
+	MinLandPercentage          float64 `json:"min_land_percentage"`           // Lowest accepted land coverage (%)
+	MaxLandPercentage          float64 `json:"max_land_percentage"`           // Highest accepted land coverage (%)
+	MinHypsometricMatch        float64 `json:"min_hypsometric_match"`         // Lowest accepted hypsometric curve match (0-1)
+	MinElevationStdDev         float64 `json:"min_elevation_std_dev"`         // Lowest accepted elevation standard deviation (m)
+	MaxElevationStdDev         float64 `json:"max_elevation_std_dev"`         // Highest accepted elevation standard deviation (m)
+	MinLargestLandmassFraction float64 `json:"min_largest_landmass_fraction"` // Smallest share of land the largest landmass may hold
+}
+
+// DefaultRealismCriteria returns Earth-realism thresholds: elevations within
+// 20% of Earth's extremes, 20-40% land, a 0.8 hypsometric match, elevation
+// spread between half and twice Earth's and no extreme fragmentation
+func DefaultRealismCriteria() RealismCriteria {
+	expectedStdDev := 2000.0 // Approximately Earth's elevation std dev
+	
+	return RealismCriteria{
+		MinElevation:               ElevationMin * 1.2, // Allow 20% tolerance
+		MaxElevation:               ElevationMax * 1.2,
+		MinLandPercentage:          20.0, // Earth is ~29% land
+		MaxLandPercentage:          40.0,
+		MinHypsometricMatch:        0.8,
+		MinElevationStdDev:         expectedStdDev * 0.5,
+		MaxElevationStdDev:         expectedStdDev * 2.0,
+		MinLargestLandmassFraction: 0.05,
 	}
 }
 
 // IsRealisticTerrain checks if terrain passes Earth-realism validation
 func IsRealisticTerrain(stats TerrainStats) (bool, []string) {
+	return IsRealisticTerrainWith(stats, DefaultRealismCriteria())
+}
+
+// IsRealisticTerrainWith checks terrain against custom realism thresholds,
+// returning whether it passes and a description of each failed criterion
+func IsRealisticTerrainWith(stats TerrainStats, criteria RealismCriteria) (bool, []string) {
 	var issues []string
 	
 	// Check elevation range
-	if stats.ElevationRange[0] < ElevationMin*1.2 { // Allow 20% tolerance
-		issues = append(issues, "minimum elevation too low (deeper than Mariana Trench)")
+	if stats.ElevationRange[0] < criteria.MinElevation {
+		issues = append(issues, fmt.Sprintf("minimum elevation too low (below %.0fm)", criteria.MinElevation))
 	}
-	if stats.ElevationRange[1] > ElevationMax*1.2 {
-		issues = append(issues, "maximum elevation too high (higher than Everest)")
+	if stats.ElevationRange[1] > criteria.MaxElevation {
+		issues = append(issues, fmt.Sprintf("maximum elevation too high (above %.0fm)", criteria.MaxElevation))
 	}
 	
-	// Check land/water ratio (Earth is ~29% land)
-	if stats.LandPercentage < 20.0 || stats.LandPercentage > 40.0 {
-		issues = append(issues, "land percentage outside realistic range (20-40%)")
+	// Check land/water ratio
+	if stats.LandPercentage < criteria.MinLandPercentage || stats.LandPercentage > criteria.MaxLandPercentage {
+		issues = append(issues, fmt.Sprintf("land percentage outside realistic range (%g-%g%%)",
+			criteria.MinLandPercentage, criteria.MaxLandPercentage))
 	}
 	
 	// Check hypsometric curve match
-	if stats.HypsometricMatch < 0.8 {
+	if stats.HypsometricMatch < criteria.MinHypsometricMatch {
 		issues = append(issues, "elevation distribution doesn't match Earth's hypsometric curve")
 	}
 	
 	// Check for reasonable elevation variance
-	expectedStdDev := 2000.0 // Approximately Earth's elevation std dev
-	if stats.ElevationStdDev < expectedStdDev*0.5 || stats.ElevationStdDev > expectedStdDev*2.0 {
+	if stats.ElevationStdDev < criteria.MinElevationStdDev || stats.ElevationStdDev > criteria.MaxElevationStdDev {
 		issues = append(issues, "elevation variance outside realistic range")
 	}
 	
+	// Check land fragmentation (only when landmass data is available)
+	if stats.LandmassCount > 0 && stats.LandTiles > 0 {
+		if float64(stats.LargestLandmass) < float64(stats.LandTiles)*criteria.MinLargestLandmassFraction {
+			issues = append(issues, fmt.Sprintf("land too fragmented (largest landmass under %g%% of total land)",
+				criteria.MinLargestLandmassFraction*100))
+		}
+	}
+	
 	return len(issues) == 0, issues
 }
 
+// ComputeQualityScore rates terrain from 0 (unrealistic) to 1 (Earth-like),
+// weighting hypsometric match by half, closeness of the land ratio to Earth's
+// by 0.3 and elevation variance by 0.2. Variance scores fully within the range
+// IsRealisticTerrain accepts and falls off in proportion outside it
+func ComputeQualityScore(stats TerrainStats) float64 {
+	if stats.TotalTiles == 0 {
+		return 0.0
+	}
+	
+	// A match of 0.5 means no correlation with Earth's curve, so it scores nothing
+	hypsometricScore := math.Max(0.0, math.Min(1.0, 2.0*stats.HypsometricMatch-1.0))
+	
+	return 0.5*hypsometricScore +
+		0.3*landRatioScore(stats.LandPercentage, LandRatioEarth) +
+		0.2*varianceScore(stats.ElevationStdDev, DefaultRealismCriteria())
+}
+
+// landRatioScore rates a land percentage from 1 at the target ratio down to 0
+// at the largest possible error, an all-land or all-water world
+func landRatioScore(landPercentage, targetLandRatio float64) float64 {
+	landError := math.Abs(landPercentage/100.0 - targetLandRatio)
+	return 1.0 - landError/math.Max(targetLandRatio, 1.0-targetLandRatio)
+}
+
+// RealismScores breaks terrain realism down into a 0-1 sub-score for each
+// criterion IsRealisticTerrain checks: "elevation_range", "land_ratio",
+// "hypsometric", "variance" and "fragmentation". A criterion scores 1 when it
+// passes and falls off in proportion to how far the terrain misses its bounds.
+// Fragmentation scores 1 when the stats carry no landmass data
+func RealismScores(stats TerrainStats) map[string]float64 {
+	return RealismScoresWithCriteria(stats, DefaultRealismCriteria())
+}
+
+// RealismScoresWithCriteria scores terrain against custom realism thresholds,
+// as IsRealisticTerrainWith checks them
+func RealismScoresWithCriteria(stats TerrainStats, criteria RealismCriteria) map[string]float64 {
+	scores := map[string]float64{
+		"elevation_range": 0.0,
+		"land_ratio":      0.0,
+		"hypsometric":     0.0,
+		"variance":        0.0,
+		"fragmentation":   0.0,
+	}
+	if stats.TotalTiles == 0 {
+		return scores
+	}
+	
+	// Elevation extremes score by how far they overshoot the tolerated depth and height
+	lowScore := 1.0
+	if stats.ElevationRange[0] < criteria.MinElevation {
+		lowScore = criteria.MinElevation / stats.ElevationRange[0]
+	}
+	highScore := 1.0
+	if stats.ElevationRange[1] > criteria.MaxElevation {
+		highScore = criteria.MaxElevation / stats.ElevationRange[1]
+	}
+	scores["elevation_range"] = clamp01(math.Min(lowScore, highScore))
+	
+	// Land ratio falls to 0 at an all-water or all-land world
+	landScore := 1.0
+	if stats.LandPercentage < criteria.MinLandPercentage {
+		landScore = stats.LandPercentage / criteria.MinLandPercentage
+	} else if stats.LandPercentage > criteria.MaxLandPercentage {
+		landScore = (100.0 - stats.LandPercentage) / (100.0 - criteria.MaxLandPercentage)
+	}
+	scores["land_ratio"] = clamp01(landScore)
+	
+	// A match of 0.5 means no correlation, so scores rise from there to the threshold
+	scores["hypsometric"] = 1.0
+	if stats.HypsometricMatch < criteria.MinHypsometricMatch {
+		scores["hypsometric"] = clamp01((stats.HypsometricMatch - 0.5) / (criteria.MinHypsometricMatch - 0.5))
+	}
+	
+	scores["variance"] = varianceScore(stats.ElevationStdDev, criteria)
+	
+	// Fragmentation scores the largest landmass's share of land against the minimum
+	scores["fragmentation"] = 1.0
+	if stats.LandmassCount > 0 && stats.LandTiles > 0 {
+		share := float64(stats.LargestLandmass) / float64(stats.LandTiles)
+		if share < criteria.MinLargestLandmassFraction {
+			scores["fragmentation"] = share / criteria.MinLargestLandmassFraction
+		}
+	}
+	
+	return scores
+}
+
+// varianceScore rates an elevation standard deviation 1 within the criteria's
+// accepted range, falling off in proportion outside it
+func varianceScore(stdDev float64, criteria RealismCriteria) float64 {
+	if stdDev < criteria.MinElevationStdDev {
+		return stdDev / criteria.MinElevationStdDev
+	}
+	if stdDev > criteria.MaxElevationStdDev {
+		return criteria.MaxElevationStdDev / stdDev
+	}
+	return 1.0
+}
+
 // ValidateHypsometricCurve checks how well elevation distribution matches Earth's
 func ValidateHypsometricCurve(elevations []float64) float64 {
 	return calculateHypsometricMatch(elevations)
@@ -138,9 +289,144 @@ func DetectElevationAnomalies(tiles []*HexTile) []string {
 	return anomalies
 }
 
+// straightCoastlineRun is the number of hexes a coastline may follow one grid
+// axis without turning before it is flagged as a generation artifact
+const straightCoastlineRun = 12
+
+// DetectGridArtifacts finds structural signs of mechanical generation, such as
+// coastlines that run perfectly straight along a grid row or column. Natural
+// coastlines wander, while axis-aligned noise artifacts leave long runs where
+// land meets water on the same side of the same grid line
+func DetectGridArtifacts(tiles []*HexTile, grid *hex.Grid) []string {
+	var artifacts []string
+	
+	if len(tiles) == 0 || grid == nil {
+		return artifacts
+	}
+	
+	// Scan the grid's offset bounding box, so shaped grids are covered too
+	tileMap := indexTiles(tiles)
+	minCol, minRow, maxCol, maxRow := grid.Bounds()
+	width, height := maxCol-minCol+1, maxRow-minRow+1
+	
+	// Which way land meets water between two cells of the bounding box: 1 for
+	// land then water, -1 for water then land, 0 for no coastline or no hex
+	boundary := func(colA, rowA, colB, rowB int) int {
+		a, okA := tileMap[hex.OffsetToAxial(minCol+colA, minRow+rowA)]
+		b, okB := tileMap[hex.OffsetToAxial(minCol+colB, minRow+rowB)]
+		switch {
+		case !okA || !okB || a.IsLand == b.IsLand:
+			return 0
+		case a.IsLand:
+			return 1
+		default:
+			return -1
+		}
+	}
+	
+	// Vertical coastlines lie between neighboring columns, followed down the rows
+	runs, longest := straightRuns(width-1, height, func(col, row int) int {
+		return boundary(col, row, col+1, row)
+	})
+	if runs > 0 {
+		artifacts = append(artifacts, fmt.Sprintf(
+			"straight vertical coastlines: %d of %d+ hexes (longest %d), likely grid artifacts",
+			runs, straightCoastlineRun, longest))
+	}
+	
+	// Horizontal coastlines lie between neighboring rows, followed across the columns
+	runs, longest = straightRuns(height-1, width, func(row, col int) int {
+		return boundary(col, row, col, row+1)
+	})
+	if runs > 0 {
+		artifacts = append(artifacts, fmt.Sprintf(
+			"straight horizontal coastlines: %d of %d+ hexes (longest %d), likely grid artifacts",
+			runs, straightCoastlineRun, longest))
+	}
+	
+	return artifacts
+}
+
+// straightRuns scans each grid line for consecutive steps with the same
+// nonzero boundary side, returning how many runs reach straightCoastlineRun
+// and the length of the longest one
+func straightRuns(lines, length int, side func(line, step int) int) (int, int) {
+	flagged, longest := 0, 0
+	
+	for line := 0; line < lines; line++ {
+		run, current := 0, 0
+		
+		// One step past the end closes any run still open
+		for step := 0; step <= length; step++ {
+			next := 0
+			if step < length {
+				next = side(line, step)
+			}
+			
+			if next != 0 && next == current {
+				run++
+				continue
+			}
+			
+			if run >= straightCoastlineRun {
+				flagged++
+				if run > longest {
+					longest = run
+				}
+			}
+			
+			current = next
+			run = 0
+			if next != 0 {
+				run = 1
+			}
+		}
+	}
+	
+	return flagged, longest
+}
+
+// HypsometricReference is a target hypsometric curve: for each fraction of
+// surface area, the elevation that fraction of the surface lies below
+type HypsometricReference struct {
+	Name        string    `json:"name"`
+	Percentiles []float64 `json:"percentiles"` // Fractions of area (0-1), ascending
+	Elevations  []float64 `json:"elevations"`  // Elevation at each percentile, in meters
+}
+
+// EarthHypsometricReference returns Earth's approximate hypsometric curve,
+// the default reference for realism validation
+func EarthHypsometricReference() HypsometricReference {
+	return HypsometricReference{
+		Name:        "earth",
+		Percentiles: []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95},
+		Elevations: []float64{
+			-6000, // 10th percentile (deep ocean)
+			-4000, // 20th percentile
+			-2000, // 30th percentile  
+			-500,  // 40th percentile
+			-100,  // 50th percentile
+			50,    // 60th percentile
+			200,   // 70th percentile (land)
+			500,   // 80th percentile
+			1000,  // 90th percentile
+			2000,  // 95th percentile
+		},
+	}
+}
+
 // calculateHypsometricMatch computes how well elevation distribution matches Earth's curve
 func calculateHypsometricMatch(elevations []float64) float64 {
-	if len(elevations) == 0 {
+	return ValidateAgainstReference(elevations, EarthHypsometricReference())
+}
+
+// ValidateAgainstReference scores from 0 to 1 how closely the shape of the
+// elevation distribution follows a reference curve, by correlating the
+// distribution's percentiles with the reference's. A flat distribution
+// matches a flat reference perfectly. Returns 0 for empty input or a
+// malformed reference
+func ValidateAgainstReference(elevations []float64, ref HypsometricReference) float64 {
+	if len(elevations) == 0 || len(ref.Percentiles) == 0 || len(ref.Percentiles) != len(ref.Elevations) {
 		return 0.0
 	}
 	
@@ -149,39 +435,42 @@ func calculateHypsometricMatch(elevations []float64) float64 {
 	copy(sorted, elevations)
 	sort.Float64s(sorted)
 	
-	// Earth's hypsometric curve percentiles (approximate)
-	earthPercentiles := []float64{
-		-6000, // 10th percentile (deep ocean)
-		-4000, // 20th percentile
-		-2000, // 30th percentile  
-		-500,  // 40th percentile
-		-100,  // 50th percentile
-		50,    // 60th percentile
-		200,   // 70th percentile (land)
-		500,   // 80th percentile
-		1000,  // 90th percentile
-		2000,  // 95th percentile
-	}
-	
 	// Calculate our terrain's percentiles
-	percentileIndices := []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95}
-	ourPercentiles := make([]float64, len(percentileIndices))
+	ourPercentiles := make([]float64, len(ref.Percentiles))
 	
-	for i, p := range percentileIndices {
+	for i, p := range ref.Percentiles {
 		index := int(p * float64(len(sorted)))
 		if index >= len(sorted) {
 			index = len(sorted) - 1
 		}
+		if index < 0 {
+			index = 0
+		}
 		ourPercentiles[i] = sorted[index]
 	}
 	
-	// Calculate correlation between our curve and Earth's curve
-	correlation := calculateCorrelation(ourPercentiles, earthPercentiles)
+	// Correlation is undefined for flat curves; two flat curves share a shape
+	if isConstant(ourPercentiles) && isConstant(ref.Elevations) {
+		return 1.0
+	}
+	
+	// Calculate correlation between our curve and the reference curve
+	correlation := calculateCorrelation(ourPercentiles, ref.Elevations)
 	
 	// Convert correlation to 0-1 range (correlation can be -1 to 1)
 	return (correlation + 1.0) / 2.0
 }
 
+// isConstant reports whether every value equals the first
+func isConstant(values []float64) bool {
+	for _, v := range values {
+		if v != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
 // Helper functions for statistical calculations
 
 func findMinMaxFloat64(values []float64) (float64, float64) {
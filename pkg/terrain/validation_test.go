@@ -1,6 +1,8 @@
 package terrain
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/sean/hex-map/pkg/hex"
@@ -51,6 +53,34 @@ func TestValidateTerrain(t *testing.T) {
 	if stats.HypsometricMatch < 0 || stats.HypsometricMatch > 1 {
 		t.Errorf("Hypsometric match should be between 0 and 1, got %f", stats.HypsometricMatch)
 	}
+	
+	// The two land tiles (1,0) and (0,1) are hex neighbors, forming one landmass
+	if stats.LandmassCount != 1 {
+		t.Errorf("Expected 1 landmass, got %d", stats.LandmassCount)
+	}
+	
+	if stats.LargestLandmass != 2 {
+		t.Errorf("Expected largest landmass of 2 tiles, got %d", stats.LargestLandmass)
+	}
+}
+
+func TestValidateTerrainLandmasses(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 10, Height: 6, Topology: hex.TopologyRegion})
+	
+	// A 3-column continent and a single-tile island
+	tiles := buildTiles(grid, func(col, row int) bool {
+		return col <= 2 || (col == 7 && row == 3)
+	})
+	
+	stats := ValidateTerrain(tiles)
+	
+	if stats.LandmassCount != 2 {
+		t.Errorf("Expected 2 landmasses, got %d", stats.LandmassCount)
+	}
+	
+	if stats.LargestLandmass != 18 {
+		t.Errorf("Expected largest landmass of 18 tiles, got %d", stats.LargestLandmass)
+	}
 }
 
 func TestValidateTerrainEmpty(t *testing.T) {
@@ -150,6 +180,34 @@ func TestIsRealisticTerrain(t *testing.T) {
 			wantValid:  false,
 			wantIssues: 5, // All 5 issues should be detected
 		},
+		{
+			name: "fragmented land",
+			stats: TerrainStats{
+				ElevationRange:   [2]float64{-3000, 3000},
+				LandPercentage:   30.0,
+				HypsometricMatch: 0.9,
+				ElevationStdDev:  2000.0,
+				LandTiles:        300,
+				LandmassCount:    150,
+				LargestLandmass:  10, // Under 5% of total land
+			},
+			wantValid:  false,
+			wantIssues: 1,
+		},
+		{
+			name: "single continent",
+			stats: TerrainStats{
+				ElevationRange:   [2]float64{-3000, 3000},
+				LandPercentage:   30.0,
+				HypsometricMatch: 0.9,
+				ElevationStdDev:  2000.0,
+				LandTiles:        300,
+				LandmassCount:    3,
+				LargestLandmass:  250,
+			},
+			wantValid:  true,
+			wantIssues: 0,
+		},
 	}
 	
 	for _, tt := range tests {
@@ -403,4 +461,248 @@ func TestValidateHypsometricCurve(t *testing.T) {
 	if emptyMatch != 0 {
 		t.Errorf("ValidateHypsometricCurve() with empty input = %f, want 0", emptyMatch)
 	}
-}
\ No newline at end of file
+}
+
+func TestValidateAgainstReference(t *testing.T) {
+	flat := make([]float64, 100)
+	flatReference := HypsometricReference{
+		Name:        "plains",
+		Percentiles: []float64{0.1, 0.5, 0.9},
+		Elevations:  []float64{0, 0, 0},
+	}
+
+	if score := ValidateAgainstReference(flat, flatReference); score < 0.9 {
+		t.Errorf("Flat terrain should match a flat reference, got %f", score)
+	}
+	if score := ValidateAgainstReference(flat, EarthHypsometricReference()); score > 0.7 {
+		t.Errorf("Flat terrain should match Earth poorly, got %f", score)
+	}
+
+	// A water world: nearly all deep ocean with a few steep islands
+	var waterWorld []float64
+	for i := 0; i < 100; i++ {
+		if i < 95 {
+			waterWorld = append(waterWorld, -4000+float64(i))
+		} else {
+			waterWorld = append(waterWorld, float64(i-94)*1000)
+		}
+	}
+	waterReference := HypsometricReference{
+		Name:        "water world",
+		Percentiles: []float64{0.1, 0.3, 0.5, 0.7, 0.9, 0.97},
+		Elevations:  []float64{-4000, -3980, -3950, -3930, -3910, 2000},
+	}
+
+	custom := ValidateAgainstReference(waterWorld, waterReference)
+	earth := ValidateAgainstReference(waterWorld, EarthHypsometricReference())
+	if custom < 0.95 || custom <= earth {
+		t.Errorf("Water world should match its reference (%f) better than Earth (%f)", custom, earth)
+	}
+
+	// The Earth reference reproduces the default match
+	if ValidateAgainstReference(flat, EarthHypsometricReference()) != calculateHypsometricMatch(flat) {
+		t.Error("Earth reference should be the default")
+	}
+
+	malformed := HypsometricReference{Percentiles: []float64{0.5}, Elevations: []float64{0, 1}}
+	if score := ValidateAgainstReference(flat, malformed); score != 0 {
+		t.Errorf("Malformed reference should score 0, got %f", score)
+	}
+}
+
+func TestComputeQualityScore(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 60, Height: 40})
+	tiles, err := GenerateTerrain(grid, DefaultTerrainConfig())
+	if err != nil {
+		t.Fatalf("GenerateTerrain failed: %v", err)
+	}
+	CalibrateSeaLevel(tiles, LandRatioEarth)
+
+	realistic := ComputeQualityScore(ValidateTerrain(tiles))
+	if realistic < 0.8 || realistic > 1.0 {
+		t.Errorf("Realistic terrain should score high, got %f", realistic)
+	}
+
+	// A flat, all-water world
+	bad := TerrainStats{
+		ElevationRange:   [2]float64{-1, -1},
+		ElevationMean:    -1,
+		ElevationStdDev:  0,
+		WaterPercentage:  100,
+		HypsometricMatch: 0.5,
+		TotalTiles:       100,
+		WaterTiles:       100,
+	}
+	if score := ComputeQualityScore(bad); score > 0.4 {
+		t.Errorf("Flat all-water terrain should score low, got %f", score)
+	}
+
+	if score := ComputeQualityScore(TerrainStats{}); score != 0 {
+		t.Errorf("Empty stats should score 0, got %f", score)
+	}
+}
+
+func TestRealismScores(t *testing.T) {
+	// Everything passes except far too much land
+	stats := TerrainStats{
+		ElevationRange:   [2]float64{-3000, 3000},
+		LandPercentage:   80.0,
+		HypsometricMatch: 0.9,
+		ElevationStdDev:  2000.0,
+		TotalTiles:       100,
+		LandTiles:        80,
+		LandmassCount:    2,
+		LargestLandmass:  70,
+	}
+
+	scores := RealismScores(stats)
+
+	for _, key := range []string{"elevation_range", "hypsometric", "variance", "fragmentation"} {
+		if scores[key] < 0.99 {
+			t.Errorf("Expected %s to score near 1, got %f", key, scores[key])
+		}
+	}
+
+	if land := scores["land_ratio"]; land > 0.5 {
+		t.Errorf("Expected land_ratio to be the weakest score, got %f", land)
+	}
+
+	// Sub-scores fall off the further terrain misses a bound
+	worse := stats
+	worse.LandPercentage = 95.0
+	if RealismScores(worse)["land_ratio"] >= scores["land_ratio"] {
+		t.Error("Expected land_ratio score to drop as land coverage grows")
+	}
+
+	extreme := stats
+	extreme.LandPercentage = 30.0
+	extreme.ElevationRange = [2]float64{-22000, 3000}
+	if score := RealismScores(extreme)["elevation_range"]; score <= 0 || score >= 1 {
+		t.Errorf("Expected partial elevation_range score, got %f", score)
+	}
+
+	// Largest landmass holds 2.5% of the land, half the 5% minimum
+	fragmented := stats
+	fragmented.LandmassCount = 60
+	fragmented.LargestLandmass = 2
+	if score := RealismScores(fragmented)["fragmentation"]; math.Abs(score-0.5) > 1e-9 {
+		t.Errorf("Expected fragmentation score 0.5, got %f", score)
+	}
+
+	// Scores follow custom criteria
+	loose := DefaultRealismCriteria()
+	loose.MaxLandPercentage = 85.0
+	if score := RealismScoresWithCriteria(stats, loose)["land_ratio"]; score != 1.0 {
+		t.Errorf("Expected land_ratio 1 under loosened criteria, got %f", score)
+	}
+
+	empty := RealismScores(TerrainStats{})
+	if len(empty) != 5 {
+		t.Errorf("Expected 5 sub-scores, got %d", len(empty))
+	}
+	for key, score := range empty {
+		if score != 0 {
+			t.Errorf("Empty stats should score 0 for %s, got %f", key, score)
+		}
+	}
+}
+
+func TestIsRealisticTerrainWith(t *testing.T) {
+	// A land-heavy world that fails only on Earth's land ratio
+	stats := TerrainStats{
+		ElevationRange:   [2]float64{-3000, 3000},
+		LandPercentage:   70.0,
+		HypsometricMatch: 0.9,
+		ElevationStdDev:  2000.0,
+	}
+
+	if ok, issues := IsRealisticTerrain(stats); ok || len(issues) != 1 {
+		t.Fatalf("Expected a single land ratio failure, got (%v, %v)", ok, issues)
+	}
+
+	// Default criteria behave exactly like IsRealisticTerrain
+	ok, issues := IsRealisticTerrainWith(stats, DefaultRealismCriteria())
+	wantOK, wantIssues := IsRealisticTerrain(stats)
+	if ok != wantOK || len(issues) != len(wantIssues) {
+		t.Errorf("Default criteria gave (%v, %v), want (%v, %v)", ok, issues, wantOK, wantIssues)
+	}
+
+	// Loosening the land bounds accepts the land-heavy world
+	criteria := DefaultRealismCriteria()
+	criteria.MinLandPercentage = 50.0
+	criteria.MaxLandPercentage = 80.0
+	if ok, issues := IsRealisticTerrainWith(stats, criteria); !ok {
+		t.Errorf("Expected loosened criteria to pass, got issues %v", issues)
+	}
+
+	// Tightening another bound fails it again with that criterion named
+	criteria.MinHypsometricMatch = 0.95
+	ok, issues = IsRealisticTerrainWith(stats, criteria)
+	if ok || len(issues) != 1 || !strings.Contains(issues[0], "hypsometric") {
+		t.Errorf("Expected a single hypsometric failure, got (%v, %v)", ok, issues)
+	}
+}
+
+func TestDetectGridArtifacts(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 30, Height: 20, Topology: hex.TopologyRegion})
+
+	// A coastline running perfectly straight down all 20 rows
+	straight := buildTiles(grid, func(col, row int) bool {
+		return col < 12
+	})
+	artifacts := DetectGridArtifacts(straight, grid)
+	if len(artifacts) != 1 || !strings.Contains(artifacts[0], "vertical") {
+		t.Errorf("Expected one vertical coastline artifact, got %v", artifacts)
+	}
+
+	// A straight east-west coastline across all 30 columns
+	horizontal := buildTiles(grid, func(col, row int) bool {
+		return row < 8
+	})
+	artifacts = DetectGridArtifacts(horizontal, grid)
+	if len(artifacts) != 1 || !strings.Contains(artifacts[0], "horizontal") {
+		t.Errorf("Expected one horizontal coastline artifact, got %v", artifacts)
+	}
+
+	// A wandering coastline never holds one column for long
+	wobble := []int{0, 1, 1, 2, 1, 0, -1, -1, 0, 2, 3, 2, 1, 0, 0, -1, -2, -1, 0, 1}
+	natural := buildTiles(grid, func(col, row int) bool {
+		return col < 12+wobble[row]
+	})
+	if artifacts := DetectGridArtifacts(natural, grid); len(artifacts) != 0 {
+		t.Errorf("Expected no artifacts for a natural coastline, got %v", artifacts)
+	}
+
+	// Runs shorter than the threshold are not flagged
+	short := buildTiles(grid, func(col, row int) bool {
+		return col < 8 && row < straightCoastlineRun-1
+	})
+	if artifacts := DetectGridArtifacts(short, grid); len(artifacts) != 0 {
+		t.Errorf("Expected short straight coast to pass, got %v", artifacts)
+	}
+
+	if artifacts := DetectGridArtifacts(nil, grid); len(artifacts) != 0 {
+		t.Errorf("Expected no artifacts for empty input, got %v", artifacts)
+	}
+}
+
+func TestDetectGridArtifactsShapedGrid(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 31, Shape: hex.ShapeHexagon, Topology: hex.TopologyRegion})
+
+	// A coastline straight down the hexagon's middle column
+	straight := buildTiles(grid, func(col, row int) bool {
+		return col < 15
+	})
+	artifacts := DetectGridArtifacts(straight, grid)
+	if len(artifacts) != 1 || !strings.Contains(artifacts[0], "vertical") {
+		t.Errorf("Expected one vertical coastline artifact on a hexagon, got %v", artifacts)
+	}
+
+	wobble := []int{0, 1, 1, 2, 1, 0, -1, -1, 0, 2, 3, 2, 1, 0, 0, -1, -2, -1, 0, 1}
+	natural := buildTiles(grid, func(col, row int) bool {
+		return col < 15+wobble[row%len(wobble)]
+	})
+	if artifacts := DetectGridArtifacts(natural, grid); len(artifacts) != 0 {
+		t.Errorf("Expected no artifacts for a natural coastline on a hexagon, got %v", artifacts)
+	}
+}
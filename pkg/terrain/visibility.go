@@ -0,0 +1,66 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// ComputeVisibility returns the hexes within maxRange steps that an observer
+// standing observerHeight meters above the observer hex can see. A hex is
+// visible unless a tile on the HexLine between them rises above the sightline,
+// comparing elevation angles per hex step. Water surfaces count as sea level.
+// On world topology sightlines wrap across the map edges
+func ComputeVisibility(observer hex.AxialCoord, observerHeight float64, tiles []*HexTile, grid *hex.Grid, maxRange int) map[hex.AxialCoord]bool {
+	visible := make(map[hex.AxialCoord]bool)
+	if maxRange < 0 || !grid.IsValid(observer) {
+		return visible
+	}
+
+	tileMap := indexTiles(tiles)
+	surface := func(coord hex.AxialCoord) float64 {
+		if tile, ok := tileMap[grid.WrapCoord(coord)]; ok {
+			return math.Max(tile.Elevation, 0)
+		}
+		return 0
+	}
+
+	eye := surface(observer) + observerHeight
+	visible[grid.WrapCoord(observer)] = true
+
+	// Cast a line to every hex in range, in unwrapped space so sightlines
+	// crossing a world seam stay straight
+	for dq := -maxRange; dq <= maxRange; dq++ {
+		for dr := max(-maxRange, -dq-maxRange); dr <= min(maxRange, -dq+maxRange); dr++ {
+			target := hex.NewAxialCoord(observer.Q+dq, observer.R+dr)
+			if !grid.IsValid(target) {
+				continue
+			}
+			if _, ok := tileMap[grid.WrapCoord(target)]; !ok {
+				continue
+			}
+
+			line := hex.HexLine(observer, target)
+			distance := len(line) - 1
+			if distance == 0 {
+				continue
+			}
+
+			// The target is hidden if any intervening hex subtends a steeper angle
+			targetSlope := (surface(target) - eye) / float64(distance)
+			blocked := false
+			for step := 1; step < distance; step++ {
+				if (surface(line[step])-eye)/float64(step) > targetSlope {
+					blocked = true
+					break
+				}
+			}
+
+			if !blocked {
+				visible[grid.WrapCoord(target)] = true
+			}
+		}
+	}
+
+	return visible
+}
@@ -0,0 +1,87 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestComputeVisibilityRidge(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 16, Height: 9, Topology: hex.TopologyRegion})
+
+	// Lowland with a tall ridge along column 6 and a higher peak at column 12
+	var tiles []*HexTile
+	for _, coord := range grid.AllCoords() {
+		col, _ := coord.ToOffset()
+		elevation := 10.0
+		switch col {
+		case 6:
+			elevation = 1500
+		case 12:
+			elevation = 8000
+		}
+		tiles = append(tiles, &HexTile{Coordinates: coord, Elevation: elevation, IsLand: true})
+	}
+
+	observer := hex.OffsetToAxial(3, 4)
+	visible := ComputeVisibility(observer, 2, tiles, grid, 10)
+
+	if !visible[observer] {
+		t.Error("Observer hex should be visible")
+	}
+
+	for _, coord := range grid.AllCoords() {
+		if observer.DistanceTo(coord, grid) > 10 {
+			if visible[coord] {
+				t.Errorf("Hex %v is beyond maxRange but marked visible", coord)
+			}
+			continue
+		}
+
+		col, _ := coord.ToOffset()
+		switch {
+		case col < 6 && !visible[coord]:
+			t.Errorf("Hex %v in front of the ridge should be visible", coord)
+		case col > 6 && col < 12 && visible[coord]:
+			t.Errorf("Lowland hex %v behind the ridge should be hidden", coord)
+		}
+	}
+
+	// The ridge face and the taller peak beyond it are visible
+	if !visible[hex.OffsetToAxial(6, 4)] {
+		t.Error("Ridge directly ahead should be visible")
+	}
+	if !visible[hex.OffsetToAxial(12, 4)] {
+		t.Error("Peak behind the ridge should be visible over it")
+	}
+}
+
+func TestComputeVisibilityFlat(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 10, Height: 10, Topology: hex.TopologyWorld})
+
+	var tiles []*HexTile
+	for _, coord := range grid.AllCoords() {
+		tiles = append(tiles, &HexTile{Coordinates: coord, Elevation: -100})
+	}
+
+	observer := hex.OffsetToAxial(0, 0)
+	visible := ComputeVisibility(observer, 5, tiles, grid, 2)
+
+	// On flat water everything in range is visible, including across the seam
+	if len(visible) != 1+3*2*3 {
+		t.Errorf("Expected %d visible hexes, got %d", 1+3*2*3, len(visible))
+	}
+	inRange := make(map[hex.AxialCoord]bool)
+	for _, coord := range observer.Spiral(2, grid) {
+		inRange[coord] = true
+	}
+	for coord := range visible {
+		if !inRange[coord] {
+			t.Errorf("Hex %v is out of range", coord)
+		}
+	}
+
+	if len(ComputeVisibility(observer, 5, tiles, grid, -1)) != 0 {
+		t.Error("Expected no visibility for negative range")
+	}
+}
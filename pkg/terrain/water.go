@@ -0,0 +1,123 @@
+package terrain
+
+import (
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+// ComputeDistanceToWater fills each tile's DistanceToWater with the number of
+// hex steps to the nearest water tile, using a multi-source BFS from all water.
+// Water tiles get 0; land tiles with no reachable water get -1
+func ComputeDistanceToWater(tiles []*HexTile, grid *hex.Grid) {
+	ComputeDistanceToWaterScaled(tiles, grid, 1.0)
+}
+
+// ComputeDistanceToWaterScaled is like ComputeDistanceToWater but multiplies
+// each step by kmPerHex, so distances are reported in kilometers
+func ComputeDistanceToWaterScaled(tiles []*HexTile, grid *hex.Grid, kmPerHex float64) {
+	steps := waterDistances(tiles, grid)
+
+	for _, tile := range tiles {
+		if distance, ok := steps[tile.Coordinates]; ok {
+			tile.DistanceToWater = float64(distance) * kmPerHex
+		} else {
+			tile.DistanceToWater = -1
+		}
+	}
+}
+
+// waterDistances returns the hex-step distance from each tile to the nearest
+// water tile. Tiles with no reachable water are absent from the result
+func waterDistances(tiles []*HexTile, grid *hex.Grid) map[hex.AxialCoord]int {
+	tileMap := indexTiles(tiles)
+	steps := make(map[hex.AxialCoord]int, len(tiles))
+
+	// Seed the BFS with every water tile
+	var queue []hex.AxialCoord
+	for _, tile := range tiles {
+		if !tile.IsLand {
+			steps[tile.Coordinates] = 0
+			queue = append(queue, tile.Coordinates)
+		}
+	}
+
+	for i := 0; i < len(queue); i++ {
+		current := queue[i]
+		for _, neighbor := range current.Neighbors(grid) {
+			if _, ok := tileMap[neighbor]; !ok {
+				continue
+			}
+			if _, seen := steps[neighbor]; seen {
+				continue
+			}
+
+			steps[neighbor] = steps[current] + 1
+			queue = append(queue, neighbor)
+		}
+	}
+
+	return steps
+}
+
+// DetectLakes finds inland water bodies and returns a lake ID for each lake
+// tile. On region maps, water connected to the grid edge is ocean; on world
+// maps, which have no edge, the largest connected water body is the ocean.
+// All other water bodies are lakes, numbered from 0 in the order their first
+// tile appears in tiles. Ocean and land tiles are absent from the result
+func DetectLakes(tiles []*HexTile, grid *hex.Grid) map[hex.AxialCoord]int {
+	tileMap := indexTiles(tiles)
+	isWater := func(c hex.AxialCoord) bool {
+		tile, ok := tileMap[c]
+		return ok && !tile.IsLand
+	}
+
+	// Group water tiles into connected bodies
+	var bodies [][]hex.AxialCoord
+	seen := make(map[hex.AxialCoord]bool)
+	for _, tile := range tiles {
+		if tile.IsLand || seen[tile.Coordinates] {
+			continue
+		}
+
+		body := grid.FloodFill(tile.Coordinates, isWater)
+		for _, coord := range body {
+			seen[coord] = true
+		}
+		bodies = append(bodies, body)
+	}
+
+	isOcean := make([]bool, len(bodies))
+	if grid.Topology() == hex.TopologyWorld {
+		largest := -1
+		for i, body := range bodies {
+			if largest < 0 || len(body) > len(bodies[largest]) {
+				largest = i
+			}
+		}
+		if largest >= 0 {
+			isOcean[largest] = true
+		}
+	} else {
+		for i, body := range bodies {
+			for _, coord := range body {
+				if coord.IsEdgeHex(grid) {
+					isOcean[i] = true
+					break
+				}
+			}
+		}
+	}
+
+	lakes := make(map[hex.AxialCoord]int)
+	nextID := 0
+	for i, body := range bodies {
+		if isOcean[i] {
+			continue
+		}
+		for _, coord := range body {
+			lakes[coord] = nextID
+		}
+		nextID++
+	}
+
+	return lakes
+}
@@ -0,0 +1,109 @@
+package terrain
+
+import (
+	"testing"
+
+	"github.com/sean/hex-map/pkg/hex"
+)
+
+func TestComputeDistanceToWater(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 9, Height: 9, Topology: hex.TopologyRegion})
+	lake := hex.OffsetToAxial(4, 4)
+
+	// A single water tile in the middle of land
+	tiles := buildTiles(grid, func(col, row int) bool {
+		return col != 4 || row != 4
+	})
+
+	ComputeDistanceToWater(tiles, grid)
+
+	for _, tile := range tiles {
+		expected := float64(tile.Coordinates.DistanceTo(lake, grid))
+		if tile.DistanceToWater != expected {
+			t.Errorf("Tile %v: expected distance %.0f, got %.0f",
+				tile.Coordinates, expected, tile.DistanceToWater)
+		}
+	}
+
+	// Distances increase moving away from the water
+	ring1 := lake.HexRing(1, grid)
+	ring3 := lake.HexRing(3, grid)
+	tileMap := indexTiles(tiles)
+	if tileMap[ring1[0]].DistanceToWater >= tileMap[ring3[0]].DistanceToWater {
+		t.Error("Expected distance to water to increase away from the water tile")
+	}
+
+	// Scaled distances are reported in kilometers
+	ComputeDistanceToWaterScaled(tiles, grid, 10.0)
+	if d := tileMap[ring3[0]].DistanceToWater; d != 30.0 {
+		t.Errorf("Expected scaled distance 30km, got %.1f", d)
+	}
+}
+
+func TestComputeDistanceToWaterNoWater(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 4, Height: 4, Topology: hex.TopologyRegion})
+	tiles := buildTiles(grid, func(col, row int) bool { return true })
+
+	ComputeDistanceToWater(tiles, grid)
+
+	for _, tile := range tiles {
+		if tile.DistanceToWater != -1 {
+			t.Errorf("Expected -1 with no water present, got %.0f", tile.DistanceToWater)
+		}
+	}
+}
+
+func TestDetectLakesBowl(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 15, Height: 15, Topology: hex.TopologyRegion})
+
+	// Ocean along the border, a land ring, and two isolated depressions
+	tiles := buildTiles(grid, func(col, row int) bool {
+		if col == 0 || row == 0 || col == 14 || row == 14 {
+			return false
+		}
+		if (col == 4 && row == 4) || (col == 5 && row == 4) {
+			return false
+		}
+		return !(col >= 9 && col <= 11 && row >= 9 && row <= 11)
+	})
+
+	lakes := DetectLakes(tiles, grid)
+
+	if len(lakes) != 2+9 {
+		t.Fatalf("Expected 11 lake tiles, got %d", len(lakes))
+	}
+
+	small := lakes[hex.OffsetToAxial(4, 4)]
+	if lakes[hex.OffsetToAxial(5, 4)] != small {
+		t.Error("Adjacent depression tiles should share a lake ID")
+	}
+	if big, ok := lakes[hex.OffsetToAxial(10, 10)]; !ok || big == small {
+		t.Errorf("Expected a second, distinct lake, got ID %d (ok=%v)", big, ok)
+	}
+	if _, ok := lakes[hex.OffsetToAxial(0, 7)]; ok {
+		t.Error("Edge-connected water should be ocean, not a lake")
+	}
+	if _, ok := lakes[hex.OffsetToAxial(7, 7)]; ok {
+		t.Error("Land should not be labeled as a lake")
+	}
+}
+
+func TestDetectLakesWorld(t *testing.T) {
+	grid := hex.NewGrid(hex.GridConfig{Width: 12, Height: 12, Topology: hex.TopologyWorld})
+
+	// A wide ocean band across the seam and a small enclosed lake
+	tiles := buildTiles(grid, func(col, row int) bool {
+		if row <= 2 || row >= 10 {
+			return false
+		}
+		return !(col == 6 && row == 6)
+	})
+
+	lakes := DetectLakes(tiles, grid)
+	if len(lakes) != 1 {
+		t.Fatalf("Expected a single lake tile, got %d", len(lakes))
+	}
+	if id, ok := lakes[hex.OffsetToAxial(6, 6)]; !ok || id != 0 {
+		t.Errorf("Expected lake 0 at the enclosed depression, got %d (ok=%v)", id, ok)
+	}
+}